@@ -208,12 +208,157 @@ func (m *PostgresAssetManager) GetPaginatedChartListWithFilters(cq ChartQuery, p
 	return charts, numPages, nil
 }
 
+func (m *PostgresAssetManager) GetChartListWithFilters(cq ChartQuery, opts ChartListOptions) ([]*models.Chart, error) {
+	whereQuery, whereQueryParams := m.GenerateWhereClause(cq)
+
+	paginationClause := ""
+	if opts.Limit > 0 {
+		paginationClause = fmt.Sprintf("LIMIT %d OFFSET %d", opts.Limit, opts.Offset)
+	} else if opts.Offset > 0 {
+		paginationClause = fmt.Sprintf("OFFSET %d", opts.Offset)
+	}
+
+	dbQuery := fmt.Sprintf("SELECT info FROM %s %s ORDER BY %s %s", dbutils.ChartTable, whereQuery, GenerateOrderByClause(opts), paginationClause)
+	return m.QueryAllCharts(dbQuery, whereQueryParams...)
+}
+
+// GetLatestChartVersions resolves the latest version of the chart matching
+// each of the queries with a single database query. Queries without a
+// matching chart are not included in the result.
+func (m *PostgresAssetManager) GetLatestChartVersions(queries []LatestChartVersionQuery) (map[LatestChartVersionQuery]models.ChartVersion, error) {
+	latestVersions := map[LatestChartVersionQuery]models.ChartVersion{}
+	uniqueQueries := []LatestChartVersionQuery{}
+	seen := map[LatestChartVersionQuery]bool{}
+	for _, q := range queries {
+		if !seen[q] {
+			seen[q] = true
+			uniqueQueries = append(uniqueQueries, q)
+		}
+	}
+	if len(uniqueQueries) == 0 {
+		return latestVersions, nil
+	}
+
+	whereQueryParams := []interface{}{m.GetKubeappsNamespace()}
+	whereClauses := []string{}
+	for _, q := range uniqueQueries {
+		if q.ChartID != "" {
+			whereQueryParams = append(whereQueryParams, q.Namespace, q.ChartID)
+			whereClauses = append(whereClauses, fmt.Sprintf(
+				"(repo_namespace = $%d AND chart_id = $%d)", len(whereQueryParams)-1, len(whereQueryParams),
+			))
+		} else if q.Version != "" && q.AppVersion != "" {
+			whereQueryParams = append(whereQueryParams, q.Namespace, q.ChartName, chartVersionsJsonbParam(q.Version, q.AppVersion))
+			whereClauses = append(whereClauses, fmt.Sprintf(
+				"((repo_namespace = $%d OR repo_namespace = $1) AND info->>'name' = $%d AND info->'chartVersions' @> $%d::jsonb)", len(whereQueryParams)-2, len(whereQueryParams)-1, len(whereQueryParams),
+			))
+		} else {
+			whereQueryParams = append(whereQueryParams, q.Namespace, q.ChartName)
+			whereClauses = append(whereClauses, fmt.Sprintf(
+				"((repo_namespace = $%d OR repo_namespace = $1) AND info->>'name' = $%d)", len(whereQueryParams)-1, len(whereQueryParams),
+			))
+		}
+	}
+	dbQuery := fmt.Sprintf("SELECT info FROM %s WHERE %s ORDER BY chart_id ASC", dbutils.ChartTable, strings.Join(whereClauses, " OR "))
+	charts, err := m.QueryAllCharts(dbQuery, whereQueryParams...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, q := range uniqueQueries {
+		var match *models.Chart
+		if q.ChartID != "" {
+			for _, chart := range charts {
+				if chart.ID == q.ChartID && chart.Repo != nil && chart.Repo.Namespace == q.Namespace {
+					match = chart
+					break
+				}
+			}
+		} else {
+			// The charts are resolved as for a single installed package.
+			candidates := []*models.Chart{}
+			for _, chart := range charts {
+				if chart.Name != q.ChartName {
+					continue
+				}
+				if q.Version != "" && q.AppVersion != "" && !hasChartVersion(chart, q.Version, q.AppVersion) {
+					continue
+				}
+				candidates = append(candidates, chart)
+			}
+			match = PreferredChartForNamespace(candidates, q.Namespace, m.GetKubeappsNamespace())
+		}
+		if match != nil && len(match.ChartVersions) > 0 {
+			latestVersions[q] = match.ChartVersions[0]
+		}
+	}
+	return latestVersions, nil
+}
+
+// The latest version of a chart split into its numeric release components and
+// its prerelease, which are NULL when the version is not of the form
+// v?<n>(.<n>)*(-<prerelease>)?(+<build>)?
+const (
+	latestVersionReleaseExpr    = `string_to_array(substring(info->'chartVersions'->0->>'version' from '^v?([0-9]+(?:\.[0-9]+)*)(?:-[0-9A-Za-z.-]+)?(?:\+.*)?$'), '.')::numeric[]`
+	latestVersionPrereleaseExpr = `substring(info->'chartVersions'->0->>'version' from '^v?[0-9]+(?:\.[0-9]+)*-([0-9A-Za-z.-]+)(?:\+.*)?$')`
+)
+
+// GenerateOrderByClause returns the ORDER BY expressions for the requested
+// sort field. Ties are broken by the chart name, the namespace and then the
+// chart ID so that the order is stable when paginating.
+//
+// This is the same ordering as the one the kubeapps-apis core server uses to
+// merge the results of several plugins (see CompareAvailablePackageSummaries
+// in cmd/kubeapps-apis/server), which relies on each plugin's pages arriving
+// in that order, so the two must be kept in sync: strings are compared
+// bytewise (COLLATE "C"), release components numerically, a prerelease sorts
+// before its release and charts without a valid version are always last.
+func GenerateOrderByClause(opts ChartListOptions) string {
+	direction := "ASC"
+	if opts.SortDescending {
+		direction = "DESC"
+	}
+	tiebreak := `(info->>'name') COLLATE "C" ASC, repo_namespace COLLATE "C" ASC, chart_id COLLATE "C" ASC`
+	switch opts.SortField {
+	case ChartSortByLatestVersion:
+		return fmt.Sprintf(`%s %s NULLS LAST, (%s IS NULL) %s, %s COLLATE "C" %s, %s`,
+			latestVersionReleaseExpr, direction,
+			latestVersionPrereleaseExpr, direction,
+			latestVersionPrereleaseExpr, direction,
+			tiebreak)
+	case ChartSortByRepository:
+		return fmt.Sprintf(`repo_namespace COLLATE "C" %s, repo_name COLLATE "C" %s, %s`, direction, direction, tiebreak)
+	default:
+		return fmt.Sprintf(`(info->>'name') COLLATE "C" %s, repo_namespace COLLATE "C" ASC, chart_id COLLATE "C" ASC`, direction)
+	}
+}
+
+// chartVersionsJsonbParam returns the parameter matching the charts including
+// the given version with the jsonb containment operator.
+func chartVersionsJsonbParam(version, appVersion string) string {
+	return fmt.Sprintf(`[{"version":"%s","app_version":"%s"}]`, version, appVersion)
+}
+
 func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []interface{}) {
 	whereClauses := []string{}
 	whereQueryParams := []interface{}{}
 	whereQuery := ""
 
-	if cq.Namespace != dbutils.AllNamespaces {
+	if len(cq.Namespaces) > 0 {
+		namespaceClauses := []string{}
+		includesKubeappsNamespace := false
+		for _, namespace := range cq.Namespaces {
+			includesKubeappsNamespace = includesKubeappsNamespace || namespace == m.GetKubeappsNamespace()
+			whereQueryParams = append(whereQueryParams, namespace)
+			namespaceClauses = append(namespaceClauses, fmt.Sprintf("repo_namespace = $%d", len(whereQueryParams)))
+		}
+		// As with a single namespace, the global charts are always included.
+		if !includesKubeappsNamespace {
+			whereQueryParams = append(whereQueryParams, m.GetKubeappsNamespace())
+			namespaceClauses = append(namespaceClauses, fmt.Sprintf("repo_namespace = $%d", len(whereQueryParams)))
+		}
+		whereClauses = append(whereClauses, "("+strings.Join(namespaceClauses, " OR ")+")")
+	} else if cq.Namespace != dbutils.AllNamespaces {
 		whereQueryParams = append(whereQueryParams, cq.Namespace, m.GetKubeappsNamespace())
 		whereClauses = append(whereClauses, fmt.Sprintf(
 			"(repo_namespace = $%d OR repo_namespace = $%d)", len(whereQueryParams)-1, len(whereQueryParams),
@@ -226,8 +371,7 @@ func (m *PostgresAssetManager) GenerateWhereClause(cq ChartQuery) (string, []int
 		))
 	}
 	if cq.Version != "" && cq.AppVersion != "" {
-		parametrizedJsonbLiteral := fmt.Sprintf(`[{"version":"%s","app_version":"%s"}]`, cq.Version, cq.AppVersion)
-		whereQueryParams = append(whereQueryParams, parametrizedJsonbLiteral)
+		whereQueryParams = append(whereQueryParams, chartVersionsJsonbParam(cq.Version, cq.AppVersion))
 		whereClauses = append(whereClauses, fmt.Sprintf("(info->'chartVersions' @> $%d::jsonb)", len(whereQueryParams)))
 	}
 
@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -443,10 +444,179 @@ func Test_GetPaginatedChartList(t *testing.T) {
 	}
 }
 
+func Test_GetChartListWithFilters(t *testing.T) {
+	availableCharts := []*models.Chart{
+		{ID: "bar", ChartVersions: []models.ChartVersion{{Digest: "456"}}},
+		{ID: "foo", ChartVersions: []models.ChartVersion{{Digest: "123"}}},
+	}
+	tests := []struct {
+		name          string
+		opts          ChartListOptions
+		expectedQuery string
+	}{
+		{
+			name:          "sorts by name without a limit",
+			opts:          ChartListOptions{},
+			expectedQuery: "SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY (info->>'name') COLLATE \"C\" ASC, repo_namespace COLLATE \"C\" ASC, chart_id COLLATE \"C\" ASC ",
+		},
+		{
+			name:          "sorts by name in descending order from an offset",
+			opts:          ChartListOptions{SortDescending: true, Offset: 3, Limit: 2},
+			expectedQuery: "SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY (info->>'name') COLLATE \"C\" DESC, repo_namespace COLLATE \"C\" ASC, chart_id COLLATE \"C\" ASC LIMIT 2 OFFSET 3",
+		},
+		{
+			name:          "sorts by repository with an offset only",
+			opts:          ChartListOptions{SortField: ChartSortByRepository, Offset: 3},
+			expectedQuery: "SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY repo_namespace COLLATE \"C\" ASC, repo_name COLLATE \"C\" ASC, (info->>'name') COLLATE \"C\" ASC, repo_namespace COLLATE \"C\" ASC, chart_id COLLATE \"C\" ASC OFFSET 3",
+		},
+		{
+			name:          "sorts by latest version",
+			opts:          ChartListOptions{SortField: ChartSortByLatestVersion, SortDescending: true, Limit: 10},
+			expectedQuery: "SELECT info FROM charts WHERE (repo_namespace = $1 OR repo_namespace = $2) ORDER BY string_to_array(substring(info->'chartVersions'->0->>'version' from '^v?([0-9]+(?:\\.[0-9]+)*)(?:-[0-9A-Za-z.-]+)?(?:\\+.*)?$'), '.')::numeric[] DESC NULLS LAST, (substring(info->'chartVersions'->0->>'version' from '^v?[0-9]+(?:\\.[0-9]+)*-([0-9A-Za-z.-]+)(?:\\+.*)?$') IS NULL) DESC, substring(info->'chartVersions'->0->>'version' from '^v?[0-9]+(?:\\.[0-9]+)*-([0-9A-Za-z.-]+)(?:\\+.*)?$') COLLATE \"C\" DESC, (info->>'name') COLLATE \"C\" ASC, repo_namespace COLLATE \"C\" ASC, chart_id COLLATE \"C\" ASC LIMIT 10 OFFSET 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pgManager, mock, cleanup := getMockManager(t)
+			defer cleanup()
+
+			rows := sqlmock.NewRows([]string{"info"})
+			for _, chart := range availableCharts {
+				chartJSON, err := json.Marshal(chart)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				rows.AddRow(string(chartJSON))
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta(tt.expectedQuery)).
+				WithArgs("other-namespace", "kubeapps").
+				WillReturnRows(rows)
+
+			charts, err := pgManager.GetChartListWithFilters(ChartQuery{Namespace: "other-namespace"}, tt.opts)
+			if err != nil {
+				t.Fatalf("Found error %v", err)
+			}
+			if !cmp.Equal(charts, availableCharts) {
+				t.Errorf("Unexpected result %v", cmp.Diff(availableCharts, charts))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("%+v", err)
+			}
+		})
+	}
+}
+
+func Test_GetLatestChartVersions(t *testing.T) {
+	pgManager, mock, cleanup := getMockManager(t)
+	defer cleanup()
+
+	availableCharts := []*models.Chart{
+		{
+			ID:            "bitnami/apache",
+			Name:          "apache",
+			Repo:          &models.Repo{Name: "bitnami", Namespace: "kubeapps"},
+			ChartVersions: []models.ChartVersion{{Version: "1.0.0", AppVersion: "2.4.1"}},
+		},
+		{
+			ID:            "my-repo/apache",
+			Name:          "apache",
+			Repo:          &models.Repo{Name: "my-repo", Namespace: "my-namespace"},
+			ChartVersions: []models.ChartVersion{{Version: "2.0.0", AppVersion: "2.4.2"}, {Version: "1.0.0"}},
+		},
+		{
+			ID:            "my-repo/wordpress",
+			Name:          "wordpress",
+			Repo:          &models.Repo{Name: "my-repo", Namespace: "my-namespace"},
+			ChartVersions: []models.ChartVersion{{Version: "3.0.0", AppVersion: "5.8.0"}},
+		},
+		// Two global charts with the same name, returned out of order, are
+		// resolved to the one with the lowest ID.
+		{
+			ID:            "tac/nginx",
+			Name:          "nginx",
+			Repo:          &models.Repo{Name: "tac", Namespace: "kubeapps"},
+			ChartVersions: []models.ChartVersion{{Version: "9.0.0", AppVersion: "1.21.1"}, {Version: "8.0.0", AppVersion: "1.20.0"}},
+		},
+		{
+			ID:            "bitnami/nginx",
+			Name:          "nginx",
+			Repo:          &models.Repo{Name: "bitnami", Namespace: "kubeapps"},
+			ChartVersions: []models.ChartVersion{{Version: "9.1.0", AppVersion: "1.21.1"}, {Version: "8.0.0", AppVersion: "1.20.0"}},
+		},
+	}
+	rows := sqlmock.NewRows([]string{"info"})
+	for _, chart := range availableCharts {
+		chartJSON, err := json.Marshal(chart)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		rows.AddRow(string(chartJSON))
+	}
+
+	expectedQuery := "SELECT info FROM charts WHERE ((repo_namespace = $2 OR repo_namespace = $1) AND info->>'name' = $3) OR " +
+		"((repo_namespace = $4 OR repo_namespace = $1) AND info->>'name' = $5) OR " +
+		"(repo_namespace = $6 AND chart_id = $7) OR " +
+		"((repo_namespace = $8 OR repo_namespace = $1) AND info->>'name' = $9) OR " +
+		"((repo_namespace = $10 OR repo_namespace = $1) AND info->>'name' = $11 AND info->'chartVersions' @> $12::jsonb) OR " +
+		"((repo_namespace = $13 OR repo_namespace = $1) AND info->>'name' = $14 AND info->'chartVersions' @> $15::jsonb) ORDER BY chart_id ASC"
+	mock.ExpectQuery(regexp.QuoteMeta(expectedQuery)).
+		WithArgs("kubeapps", "my-namespace", "apache", "other-namespace", "apache", "my-namespace", "my-repo/wordpress", "other-namespace", "unknown",
+			"my-namespace", "nginx", `[{"version":"8.0.0","app_version":"1.20.0"}]`,
+			"my-namespace", "apache", `[{"version":"1.0.0","app_version":"2.4.1"}]`).
+		WillReturnRows(rows)
+
+	queries := []LatestChartVersionQuery{
+		{Namespace: "my-namespace", ChartName: "apache"},
+		{Namespace: "other-namespace", ChartName: "apache"},
+		{Namespace: "my-namespace", ChartName: "apache"},
+		{Namespace: "my-namespace", ChartID: "my-repo/wordpress"},
+		{Namespace: "other-namespace", ChartName: "unknown"},
+		{Namespace: "my-namespace", ChartName: "nginx", Version: "8.0.0", AppVersion: "1.20.0"},
+		// The chart of the namespace does not include the installed version.
+		{Namespace: "my-namespace", ChartName: "apache", Version: "1.0.0", AppVersion: "2.4.1"},
+	}
+	latestVersions, err := pgManager.GetLatestChartVersions(queries)
+	if err != nil {
+		t.Fatalf("Found error %v", err)
+	}
+
+	expectedVersions := map[LatestChartVersionQuery]models.ChartVersion{
+		{Namespace: "my-namespace", ChartName: "apache"}:                                        {Version: "2.0.0", AppVersion: "2.4.2"},
+		{Namespace: "other-namespace", ChartName: "apache"}:                                     {Version: "1.0.0", AppVersion: "2.4.1"},
+		{Namespace: "my-namespace", ChartID: "my-repo/wordpress"}:                               {Version: "3.0.0", AppVersion: "5.8.0"},
+		{Namespace: "my-namespace", ChartName: "nginx", Version: "8.0.0", AppVersion: "1.20.0"}: {Version: "9.1.0", AppVersion: "1.21.1"},
+		{Namespace: "my-namespace", ChartName: "apache", Version: "1.0.0", AppVersion: "2.4.1"}: {Version: "1.0.0", AppVersion: "2.4.1"},
+	}
+	if !cmp.Equal(latestVersions, expectedVersions) {
+		t.Errorf("Unexpected result %v", cmp.Diff(expectedVersions, latestVersions))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+func Test_GetLatestChartVersionsWithoutQueries(t *testing.T) {
+	pgManager, mock, cleanup := getMockManager(t)
+	defer cleanup()
+
+	latestVersions, err := pgManager.GetLatestChartVersions(nil)
+	if err != nil {
+		t.Fatalf("Found error %v", err)
+	}
+	if len(latestVersions) != 0 {
+		t.Errorf("got: %v, want: no versions", latestVersions)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
 func Test_GenerateWhereClause(t *testing.T) {
 	tests := []struct {
 		name           string
 		namespace      string
+		namespaces     []string
 		chartName      string
 		version        string
 		appVersion     string
@@ -468,6 +638,19 @@ func Test_GenerateWhereClause(t *testing.T) {
 			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2)",
 			expectedParams: []interface{}{string(""), string("kubeapps")},
 		},
+		{
+			name:           "returns where clause - multiple namespaces",
+			namespaces:     []string{"my-ns", "other-ns"},
+			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2 OR repo_namespace = $3)",
+			expectedParams: []interface{}{string("my-ns"), string("other-ns"), string("kubeapps")},
+		},
+		{
+			name:           "returns where clause - multiple namespaces including the global one",
+			namespaces:     []string{"kubeapps", "my-ns"},
+			repos:          []string{"my-repo1"},
+			expectedClause: "WHERE (repo_namespace = $1 OR repo_namespace = $2) AND ((repo_name = $3))",
+			expectedParams: []interface{}{string("kubeapps"), string("my-ns"), string("my-repo1")},
+		},
 		{
 			name:           "returns where clause - single param - namespace",
 			namespace:      "my-ns",
@@ -656,6 +839,7 @@ func Test_GenerateWhereClause(t *testing.T) {
 
 			cq := ChartQuery{
 				Namespace:   tt.namespace,
+				Namespaces:  tt.namespaces,
 				ChartName:   tt.chartName,
 				Version:     tt.version,
 				AppVersion:  tt.appVersion,
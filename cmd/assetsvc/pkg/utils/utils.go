@@ -28,12 +28,70 @@ type AssetManager interface {
 	GetChartVersion(namespace, chartID, version string) (models.Chart, error)
 	GetChartFiles(namespace, filesID string) (models.ChartFiles, error)
 	GetPaginatedChartListWithFilters(cq ChartQuery, pageNumber, pageSize int) ([]*models.Chart, int, error)
+	GetChartListWithFilters(cq ChartQuery, opts ChartListOptions) ([]*models.Chart, error)
 	GetAllChartCategories(cq ChartQuery) ([]*models.ChartCategory, error)
+	GetLatestChartVersions(queries []LatestChartVersionQuery) (map[LatestChartVersionQuery]models.ChartVersion, error)
+}
+
+// LatestChartVersionQuery identifies a chart available in a namespace, which
+// includes the global charts, either by its name or, when known, by its ID.
+type LatestChartVersionQuery struct {
+	Namespace string
+	ChartName string
+	// Version and AppVersion, when both are set, restrict a query by ChartName
+	// to the charts including that version, as when looking up the chart of
+	// an installed package.
+	Version    string
+	AppVersion string
+	// ChartID, when not empty, is used instead of ChartName and matches only
+	// the charts of the given namespace.
+	ChartID string
+}
+
+// PreferredChartForNamespace returns, among the charts found by name for the
+// given namespace, the one a package of that namespace is resolved to: a
+// chart of the namespace itself takes precedence over a global one and ties
+// are broken by the lowest chart ID. It returns nil when none is available.
+func PreferredChartForNamespace(charts []*models.Chart, namespace, globalNamespace string) *models.Chart {
+	var preferred *models.Chart
+	for _, chart := range charts {
+		if chart.Repo == nil || (chart.Repo.Namespace != namespace && chart.Repo.Namespace != globalNamespace) {
+			continue
+		}
+		if preferred == nil {
+			preferred = chart
+			continue
+		}
+		fromNamespace, preferredFromNamespace := chart.Repo.Namespace == namespace, preferred.Repo.Namespace == namespace
+		if fromNamespace != preferredFromNamespace {
+			if fromNamespace {
+				preferred = chart
+			}
+			continue
+		}
+		if chart.ID < preferred.ID {
+			preferred = chart
+		}
+	}
+	return preferred
+}
+
+// hasChartVersion returns whether the chart includes the given version.
+func hasChartVersion(chart *models.Chart, version, appVersion string) bool {
+	for _, chartVersion := range chart.ChartVersions {
+		if chartVersion.Version == version && chartVersion.AppVersion == appVersion {
+			return true
+		}
+	}
+	return false
 }
 
 // ChartQuery is a container for passing the supported query parameters for generating the WHERE query
 type ChartQuery struct {
-	Namespace   string
+	Namespace string
+	// Namespaces, when not empty, is used instead of Namespace to query the
+	// charts of any of a set of namespaces.
+	Namespaces  []string
 	ChartName   string
 	Version     string
 	AppVersion  string
@@ -42,6 +100,26 @@ type ChartQuery struct {
 	Categories  []string
 }
 
+// ChartSortField identifies the field used to order a list of charts
+type ChartSortField int
+
+const (
+	ChartSortByName ChartSortField = iota
+	ChartSortByLatestVersion
+	ChartSortByRepository
+)
+
+// ChartListOptions is a container for the order and the window of charts
+// returned by GetChartListWithFilters. Unlike the page number used by
+// GetPaginatedChartListWithFilters, the offset is a number of charts so that
+// the results can be merged with those from other sources.
+type ChartListOptions struct {
+	SortField      ChartSortField
+	SortDescending bool
+	Offset         int
+	Limit          int
+}
+
 func NewManager(databaseType string, config datastore.Config, kubeappsNamespace string) (AssetManager, error) {
 	return NewPGManager(config, kubeappsNamespace)
 }
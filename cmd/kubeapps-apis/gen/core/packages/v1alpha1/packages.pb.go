@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -24,6 +25,135 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// StatusFilter
+//
+// A status of the installed packages to be included.
+type GetInstalledPackageSummariesRequest_StatusFilter int32
+
+const (
+	// Include the deployed and failed installed packages (default)
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_UNSPECIFIED GetInstalledPackageSummariesRequest_StatusFilter = 0
+	// Include the deployed installed packages
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_DEPLOYED GetInstalledPackageSummariesRequest_StatusFilter = 1
+	// Include the installed packages which failed to be deployed
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_FAILED GetInstalledPackageSummariesRequest_StatusFilter = 2
+	// Include the installed packages with a pending install, upgrade or rollback
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_PENDING GetInstalledPackageSummariesRequest_StatusFilter = 3
+	// Include the uninstalled packages for which the history was kept
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_UNINSTALLED GetInstalledPackageSummariesRequest_StatusFilter = 4
+	// Include the installed packages with any status
+	GetInstalledPackageSummariesRequest_STATUS_FILTER_ALL GetInstalledPackageSummariesRequest_StatusFilter = 5
+)
+
+// Enum value maps for GetInstalledPackageSummariesRequest_StatusFilter.
+var (
+	GetInstalledPackageSummariesRequest_StatusFilter_name = map[int32]string{
+		0: "STATUS_FILTER_UNSPECIFIED",
+		1: "STATUS_FILTER_DEPLOYED",
+		2: "STATUS_FILTER_FAILED",
+		3: "STATUS_FILTER_PENDING",
+		4: "STATUS_FILTER_UNINSTALLED",
+		5: "STATUS_FILTER_ALL",
+	}
+	GetInstalledPackageSummariesRequest_StatusFilter_value = map[string]int32{
+		"STATUS_FILTER_UNSPECIFIED": 0,
+		"STATUS_FILTER_DEPLOYED":    1,
+		"STATUS_FILTER_FAILED":      2,
+		"STATUS_FILTER_PENDING":     3,
+		"STATUS_FILTER_UNINSTALLED": 4,
+		"STATUS_FILTER_ALL":         5,
+	}
+)
+
+func (x GetInstalledPackageSummariesRequest_StatusFilter) Enum() *GetInstalledPackageSummariesRequest_StatusFilter {
+	p := new(GetInstalledPackageSummariesRequest_StatusFilter)
+	*p = x
+	return p
+}
+
+func (x GetInstalledPackageSummariesRequest_StatusFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetInstalledPackageSummariesRequest_StatusFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[0].Descriptor()
+}
+
+func (GetInstalledPackageSummariesRequest_StatusFilter) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[0]
+}
+
+func (x GetInstalledPackageSummariesRequest_StatusFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetInstalledPackageSummariesRequest_StatusFilter.Descriptor instead.
+func (GetInstalledPackageSummariesRequest_StatusFilter) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// Sort field
+//
+// The fields by which results can be sorted. Ties are always broken by the
+// package name and then the package identifier so that the order is stable
+// across pages.
+type SortOptions_SortField int32
+
+const (
+	// Sort by the package name (default)
+	SortOptions_SORT_FIELD_UNSPECIFIED SortOptions_SortField = 0
+	// Sort by the package name
+	SortOptions_SORT_FIELD_NAME SortOptions_SortField = 1
+	// Sort by the latest version of the package
+	SortOptions_SORT_FIELD_LATEST_VERSION SortOptions_SortField = 2
+	// Sort by the repository of the package, that is the namespace of the
+	// package reference followed by the repository part of its identifier
+	SortOptions_SORT_FIELD_REPOSITORY SortOptions_SortField = 3
+)
+
+// Enum value maps for SortOptions_SortField.
+var (
+	SortOptions_SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "SORT_FIELD_NAME",
+		2: "SORT_FIELD_LATEST_VERSION",
+		3: "SORT_FIELD_REPOSITORY",
+	}
+	SortOptions_SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED":    0,
+		"SORT_FIELD_NAME":           1,
+		"SORT_FIELD_LATEST_VERSION": 2,
+		"SORT_FIELD_REPOSITORY":     3,
+	}
+)
+
+func (x SortOptions_SortField) Enum() *SortOptions_SortField {
+	p := new(SortOptions_SortField)
+	*p = x
+	return p
+}
+
+func (x SortOptions_SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOptions_SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[1].Descriptor()
+}
+
+func (SortOptions_SortField) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[1]
+}
+
+func (x SortOptions_SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOptions_SortField.Descriptor instead.
+func (SortOptions_SortField) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{39, 0}
+}
+
 // StatusReason
 //
 // Generic reasons why an installed package may be ready or not.
@@ -67,11 +197,11 @@ func (x InstalledPackageStatus_StatusReason) String() string {
 }
 
 func (InstalledPackageStatus_StatusReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[0].Descriptor()
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[2].Descriptor()
 }
 
 func (InstalledPackageStatus_StatusReason) Type() protoreflect.EnumType {
-	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[0]
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[2]
 }
 
 func (x InstalledPackageStatus_StatusReason) Number() protoreflect.EnumNumber {
@@ -80,7 +210,62 @@ func (x InstalledPackageStatus_StatusReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstalledPackageStatus_StatusReason.Descriptor instead.
 func (InstalledPackageStatus_StatusReason) EnumDescriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{23, 0}
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{42, 0}
+}
+
+// DiffType
+//
+// Whether the resource would be added, removed or changed.
+type ResourceDiff_DiffType int32
+
+const (
+	ResourceDiff_DIFF_TYPE_UNSPECIFIED ResourceDiff_DiffType = 0
+	ResourceDiff_DIFF_TYPE_ADDED       ResourceDiff_DiffType = 1
+	ResourceDiff_DIFF_TYPE_REMOVED     ResourceDiff_DiffType = 2
+	ResourceDiff_DIFF_TYPE_CHANGED     ResourceDiff_DiffType = 3
+)
+
+// Enum value maps for ResourceDiff_DiffType.
+var (
+	ResourceDiff_DiffType_name = map[int32]string{
+		0: "DIFF_TYPE_UNSPECIFIED",
+		1: "DIFF_TYPE_ADDED",
+		2: "DIFF_TYPE_REMOVED",
+		3: "DIFF_TYPE_CHANGED",
+	}
+	ResourceDiff_DiffType_value = map[string]int32{
+		"DIFF_TYPE_UNSPECIFIED": 0,
+		"DIFF_TYPE_ADDED":       1,
+		"DIFF_TYPE_REMOVED":     2,
+		"DIFF_TYPE_CHANGED":     3,
+	}
+)
+
+func (x ResourceDiff_DiffType) Enum() *ResourceDiff_DiffType {
+	p := new(ResourceDiff_DiffType)
+	*p = x
+	return p
+}
+
+func (x ResourceDiff_DiffType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceDiff_DiffType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[3].Descriptor()
+}
+
+func (ResourceDiff_DiffType) Type() protoreflect.EnumType {
+	return &file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes[3]
+}
+
+func (x ResourceDiff_DiffType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceDiff_DiffType.Descriptor instead.
+func (ResourceDiff_DiffType) EnumDescriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{52, 0}
 }
 
 // GetAvailablePackageSummariesRequest
@@ -97,6 +282,9 @@ type GetAvailablePackageSummariesRequest struct {
 	FilterOptions *FilterOptions `protobuf:"bytes,2,opt,name=filter_options,json=filterOptions,proto3" json:"filter_options,omitempty"`
 	// Pagination options specifying where to start and how many results to include.
 	PaginationOptions *PaginationOptions `protobuf:"bytes,3,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
+	// Sort options specifying the order of the results. Defaults to sorting
+	// by name.
+	SortOptions *SortOptions `protobuf:"bytes,4,opt,name=sort_options,json=sortOptions,proto3" json:"sort_options,omitempty"`
 }
 
 func (x *GetAvailablePackageSummariesRequest) Reset() {
@@ -152,6 +340,13 @@ func (x *GetAvailablePackageSummariesRequest) GetPaginationOptions() *Pagination
 	return nil
 }
 
+func (x *GetAvailablePackageSummariesRequest) GetSortOptions() *SortOptions {
+	if x != nil {
+		return x.SortOptions
+	}
+	return nil
+}
+
 // GetAvailablePackageDetailRequest
 //
 // Request for GetAvailablePackageDetail
@@ -291,6 +486,9 @@ type GetInstalledPackageSummariesRequest struct {
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	// Pagination options specifying where to start and how many results to include.
 	PaginationOptions *PaginationOptions `protobuf:"bytes,2,opt,name=pagination_options,json=paginationOptions,proto3" json:"pagination_options,omitempty"`
+	// The statuses of the installed packages to be included. When not set,
+	// only the deployed and failed installed packages are returned.
+	StatusFilters []GetInstalledPackageSummariesRequest_StatusFilter `protobuf:"varint,3,rep,packed,name=status_filters,json=statusFilters,proto3,enum=kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest_StatusFilter" json:"status_filters,omitempty"`
 }
 
 func (x *GetInstalledPackageSummariesRequest) Reset() {
@@ -339,6 +537,13 @@ func (x *GetInstalledPackageSummariesRequest) GetPaginationOptions() *Pagination
 	return nil
 }
 
+func (x *GetInstalledPackageSummariesRequest) GetStatusFilters() []GetInstalledPackageSummariesRequest_StatusFilter {
+	if x != nil {
+		return x.StatusFilters
+	}
+	return nil
+}
+
 // GetInstalledPackageDetailRequest
 //
 // Request for GetInstalledPackageDetail
@@ -416,6 +621,9 @@ type CreateInstalledPackageRequest struct {
 	// An optional field for specifying data common to systems that reconcile
 	// the package on the cluster.
 	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// An optional ordered list of sources of values, deep-merged in order
+	// before the values above are layered on top of them.
+	ValueSources []*ValueSource `protobuf:"bytes,7,rep,name=value_sources,json=valueSources,proto3" json:"value_sources,omitempty"`
 }
 
 func (x *CreateInstalledPackageRequest) Reset() {
@@ -492,31 +700,41 @@ func (x *CreateInstalledPackageRequest) GetReconciliationOptions() *Reconciliati
 	return nil
 }
 
-// GetAvailablePackageSummariesResponse
+func (x *CreateInstalledPackageRequest) GetValueSources() []*ValueSource {
+	if x != nil {
+		return x.ValueSources
+	}
+	return nil
+}
+
+// RenderInstalledPackageRequest
 //
-// Response for GetAvailablePackageSummaries
-type GetAvailablePackageSummariesResponse struct {
+// Request for RenderInstalledPackage, with the same fields as the request to
+// create the installed package.
+type RenderInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available packages summaries
-	//
-	// List of AvailablePackageSummary
-	AvailablePackageSummaries []*AvailablePackageSummary `protobuf:"bytes,1,rep,name=available_package_summaries,json=availablePackageSummaries,proto3" json:"available_package_summaries,omitempty"`
-	// Next page token
-	//
-	// This field represents the pagination token to retrieve the next page of
-	// results. If the value is "", it means no further results for the request.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// Categories
-	//
-	// This optional field contains the distinct category names considering the FilterOptions.
-	Categories []string `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// A reference uniquely identifying the package available for installation.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// The target context where the package is intended to be installed.
+	TargetContext *Context `protobuf:"bytes,2,opt,name=target_context,json=targetContext,proto3" json:"target_context,omitempty"`
+	// A user-provided name for the installed package (eg. project-x-db)
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// For helm this will be the exact version in VersionReference.version
+	// For other plugins we can extend the VersionReference as needed.
+	PkgVersionReference *VersionReference `protobuf:"bytes,4,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// An optional serialized values string to be included when templating a package
+	// in the format expected by the plugin.
+	Values string `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
+	// An optional ordered list of sources of values, deep-merged in order
+	// before the values above are layered on top of them.
+	ValueSources []*ValueSource `protobuf:"bytes,6,rep,name=value_sources,json=valueSources,proto3" json:"value_sources,omitempty"`
 }
 
-func (x *GetAvailablePackageSummariesResponse) Reset() {
-	*x = GetAvailablePackageSummariesResponse{}
+func (x *RenderInstalledPackageRequest) Reset() {
+	*x = RenderInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,13 +742,13 @@ func (x *GetAvailablePackageSummariesResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageSummariesResponse) String() string {
+func (x *RenderInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageSummariesResponse) ProtoMessage() {}
+func (*RenderInstalledPackageRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Message {
+func (x *RenderInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,48 +760,77 @@ func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageSummariesResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageSummariesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*RenderInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetAvailablePackageSummaries() []*AvailablePackageSummary {
+func (x *RenderInstalledPackageRequest) GetAvailablePackageRef() *AvailablePackageReference {
 	if x != nil {
-		return x.AvailablePackageSummaries
+		return x.AvailablePackageRef
 	}
 	return nil
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetNextPageToken() string {
+func (x *RenderInstalledPackageRequest) GetTargetContext() *Context {
 	if x != nil {
-		return x.NextPageToken
+		return x.TargetContext
+	}
+	return nil
+}
+
+func (x *RenderInstalledPackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetAvailablePackageSummariesResponse) GetCategories() []string {
+func (x *RenderInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
 	if x != nil {
-		return x.Categories
+		return x.PkgVersionReference
 	}
 	return nil
 }
 
-// GetAvailablePackageDetailResponse
+func (x *RenderInstalledPackageRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *RenderInstalledPackageRequest) GetValueSources() []*ValueSource {
+	if x != nil {
+		return x.ValueSources
+	}
+	return nil
+}
+
+// DiffInstalledPackageRequest
 //
-// Response for GetAvailablePackageDetail
-type GetAvailablePackageDetailResponse struct {
+// Request for DiffInstalledPackage, with the same fields as the request to
+// update the installed package.
+type DiffInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package detail
-	//
-	// The requested AvailablePackageDetail
-	AvailablePackageDetail *AvailablePackageDetail `protobuf:"bytes,1,opt,name=available_package_detail,json=availablePackageDetail,proto3" json:"available_package_detail,omitempty"`
+	// A reference uniquely identifying the installed package to be updated.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// For helm this will be the exact version in VersionReference.version
+	// For other plugins we can extend the VersionReference as needed.
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// An optional serialized values string to be included when templating a
+	// package in the format expected by the plugin.
+	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	// An optional ordered list of sources of values, deep-merged in order
+	// before the values above are layered on top of them.
+	ValueSources []*ValueSource `protobuf:"bytes,4,rep,name=value_sources,json=valueSources,proto3" json:"value_sources,omitempty"`
 }
 
-func (x *GetAvailablePackageDetailResponse) Reset() {
-	*x = GetAvailablePackageDetailResponse{}
+func (x *DiffInstalledPackageRequest) Reset() {
+	*x = DiffInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,13 +838,13 @@ func (x *GetAvailablePackageDetailResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageDetailResponse) String() string {
+func (x *DiffInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageDetailResponse) ProtoMessage() {}
+func (*DiffInstalledPackageRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message {
+func (x *DiffInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,54 +856,68 @@ func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageDetailResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageDetailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*DiffInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetAvailablePackageDetailResponse) GetAvailablePackageDetail() *AvailablePackageDetail {
+func (x *DiffInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.AvailablePackageDetail
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// GetAvailablePackageVersionsResponse
+func (x *DiffInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *DiffInstalledPackageRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *DiffInstalledPackageRequest) GetValueSources() []*ValueSource {
+	if x != nil {
+		return x.ValueSources
+	}
+	return nil
+}
+
+// UpdateInstalledPackageRequest
 //
-// Response for GetAvailablePackageVersions
-type GetAvailablePackageVersionsResponse struct {
+// Request for UpdateInstalledPackage
+type UpdateInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Package app versions
-	//
-	// By default (when version_query is empty or ignored) the response
-	// should contain an ordered summary of versions including the most recent three
-	// patch versions of the most recent three minor versions of the most recent three
-	// major versions when available, something like:
-	// [
-	//   { pkg_version: "10.3.19", app_version: "2.16.8" },
-	//   { pkg_version: "10.3.18", app_version: "2.16.8" },
-	//   { pkg_version: "10.3.17", app_version: "2.16.7" },
-	//   { pkg_version: "10.2.6", app_version: "2.15.3" },
-	//   { pkg_version: "10.2.5", app_version: "2.15.2" },
-	//   { pkg_version: "10.2.4", app_version: "2.15.2" },
-	//   { pkg_version: "10.1.8", app_version: "2.13.5" },
-	//   { pkg_version: "10.1.7", app_version: "2.13.5" },
-	//   { pkg_version: "10.1.6", app_version: "2.13.5" },
-	//   { pkg_version: "9.5.4", app_version: "2.8.9" },
-	//   ...
-	//   { pkg_version: "8.2.5", app_version: "1.19.5" },
-	//   ...
-	// ]
-	// If a version_query is present and the plugin chooses to support it,
-	// the full history of versions matching the version query should be returned.
-	PackageAppVersions []*PackageAppVersion `protobuf:"bytes,1,rep,name=package_app_versions,json=packageAppVersions,proto3" json:"package_app_versions,omitempty"`
+	// A reference uniquely identifying the installed package being updated.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// For helm this will be the exact version in VersionReference.version
+	// For other plugins we can extend the VersionReference as needed.
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// An optional serialized values string to be included when templating a
+	// package in the format expected by the plugin. Included when the backend
+	// format doesn't use secrets or configmaps for values or supports both.
+	// These values are layered on top of any values refs above, when
+	// relevant.
+	Values string `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	// An optional field for specifying data common to systems that reconcile
+	// the package on the cluster.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,4,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// An optional ordered list of sources of values, deep-merged in order
+	// before the values above are layered on top of them.
+	ValueSources []*ValueSource `protobuf:"bytes,5,rep,name=value_sources,json=valueSources,proto3" json:"value_sources,omitempty"`
 }
 
-func (x *GetAvailablePackageVersionsResponse) Reset() {
-	*x = GetAvailablePackageVersionsResponse{}
+func (x *UpdateInstalledPackageRequest) Reset() {
+	*x = UpdateInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,13 +925,13 @@ func (x *GetAvailablePackageVersionsResponse) Reset() {
 	}
 }
 
-func (x *GetAvailablePackageVersionsResponse) String() string {
+func (x *UpdateInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailablePackageVersionsResponse) ProtoMessage() {}
+func (*UpdateInstalledPackageRequest) ProtoMessage() {}
 
-func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,39 +943,63 @@ func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailablePackageVersionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailablePackageVersionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetAvailablePackageVersionsResponse) GetPackageAppVersions() []*PackageAppVersion {
+func (x *UpdateInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.PackageAppVersions
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// GetInstalledPackageSummariesResponse
-//
-// Response for GetInstalledPackageSummaries
-type GetInstalledPackageSummariesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Installed packages summaries
-	//
-	// List of InstalledPackageSummary
-	InstalledPackageSummaries []*InstalledPackageSummary `protobuf:"bytes,1,rep,name=installed_package_summaries,json=installedPackageSummaries,proto3" json:"installed_package_summaries,omitempty"`
-	// Next page token
-	//
-	// This field represents the pagination token to retrieve the next page of
-	// results. If the value is "", it means no further results for the request.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *UpdateInstalledPackageRequest) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
 }
 
-func (x *GetInstalledPackageSummariesResponse) Reset() {
-	*x = GetInstalledPackageSummariesResponse{}
+func (x *UpdateInstalledPackageRequest) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *UpdateInstalledPackageRequest) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+func (x *UpdateInstalledPackageRequest) GetValueSources() []*ValueSource {
+	if x != nil {
+		return x.ValueSources
+	}
+	return nil
+}
+
+// DeleteInstalledPackageRequest
+//
+// Request for DeleteInstalledPackage
+type DeleteInstalledPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference uniquely identifying the installed package being deleted.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// An optional flag to keep the history of the installed package, when the
+	// plugin supports it (eg. helm's --keep-history).
+	KeepHistory bool `protobuf:"varint,2,opt,name=keep_history,json=keepHistory,proto3" json:"keep_history,omitempty"`
+}
+
+func (x *DeleteInstalledPackageRequest) Reset() {
+	*x = DeleteInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +1007,13 @@ func (x *GetInstalledPackageSummariesResponse) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageSummariesResponse) String() string {
+func (x *DeleteInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageSummariesResponse) ProtoMessage() {}
+func (*DeleteInstalledPackageRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteInstalledPackageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,41 +1025,43 @@ func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageSummariesResponse.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageSummariesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackageRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetInstalledPackageSummariesResponse) GetInstalledPackageSummaries() []*InstalledPackageSummary {
+func (x *DeleteInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.InstalledPackageSummaries
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-func (x *GetInstalledPackageSummariesResponse) GetNextPageToken() string {
+func (x *DeleteInstalledPackageRequest) GetKeepHistory() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.KeepHistory
 	}
-	return ""
+	return false
 }
 
-// GetInstalledPackageDetailResponse
+// WatchInstalledPackagesRequest
 //
-// Response for GetInstalledPackageDetail
-type GetInstalledPackageDetailResponse struct {
+// Request for WatchInstalledPackages
+type WatchInstalledPackagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// InstalledPackageDetail
-	//
-	// The requested InstalledPackageDetail
-	InstalledPackageDetail *InstalledPackageDetail `protobuf:"bytes,1,opt,name=installed_package_detail,json=installedPackageDetail,proto3" json:"installed_package_detail,omitempty"`
+	// The context (cluster/namespace) for the request. An empty namespace
+	// watches the installed packages of all namespaces.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// An optional reference to watch a single installed package, such as one
+	// which has just been created. When set, only its plugin is watched.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,2,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *GetInstalledPackageDetailResponse) Reset() {
-	*x = GetInstalledPackageDetailResponse{}
+func (x *WatchInstalledPackagesRequest) Reset() {
+	*x = WatchInstalledPackagesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -782,13 +1069,13 @@ func (x *GetInstalledPackageDetailResponse) Reset() {
 	}
 }
 
-func (x *GetInstalledPackageDetailResponse) String() string {
+func (x *WatchInstalledPackagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstalledPackageDetailResponse) ProtoMessage() {}
+func (*WatchInstalledPackagesRequest) ProtoMessage() {}
 
-func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchInstalledPackagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,31 +1087,40 @@ func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstalledPackageDetailResponse.ProtoReflect.Descriptor instead.
-func (*GetInstalledPackageDetailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchInstalledPackagesRequest.ProtoReflect.Descriptor instead.
+func (*WatchInstalledPackagesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetInstalledPackageDetailResponse) GetInstalledPackageDetail() *InstalledPackageDetail {
+func (x *WatchInstalledPackagesRequest) GetContext() *Context {
 	if x != nil {
-		return x.InstalledPackageDetail
+		return x.Context
 	}
 	return nil
 }
 
-// CreateInstalledPackageResponse
+func (x *WatchInstalledPackagesRequest) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// GetInstalledPackageResourceRefsRequest
 //
-// Response for CreateInstalledPackage
-type CreateInstalledPackageResponse struct {
+// Request for GetInstalledPackageResourceRefs
+type GetInstalledPackageResourceRefsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The information required to uniquely
+	// identify an installed package
 	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *CreateInstalledPackageResponse) Reset() {
-	*x = CreateInstalledPackageResponse{}
+func (x *GetInstalledPackageResourceRefsRequest) Reset() {
+	*x = GetInstalledPackageResourceRefsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,13 +1128,13 @@ func (x *CreateInstalledPackageResponse) Reset() {
 	}
 }
 
-func (x *CreateInstalledPackageResponse) String() string {
+func (x *GetInstalledPackageResourceRefsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInstalledPackageResponse) ProtoMessage() {}
+func (*GetInstalledPackageResourceRefsRequest) ProtoMessage() {}
 
-func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageResourceRefsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -850,61 +1146,33 @@ func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInstalledPackageResponse.ProtoReflect.Descriptor instead.
-func (*CreateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageResourceRefsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageResourceRefsRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+func (x *GetInstalledPackageResourceRefsRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
 		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// AvailablePackageSummary
+// GetInstalledPackageResourceStatusesRequest
 //
-// An AvailablePackageSummary provides a summary of a package available for installation
-// useful when aggregating many available packages.
-type AvailablePackageSummary struct {
+// Request for GetInstalledPackageResourceStatuses
+type GetInstalledPackageResourceStatusesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package reference
-	//
-	// A reference uniquely identifying the package.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// Available package name
-	//
-	// The name of the available package
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Latest available version
-	//
-	// The latest version available for this package. Often expected when viewing
-	// a summary of many available packages.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Available package Icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// Available package display name
-	//
-	// A name as displayed to users
-	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Available package short description
-	//
-	// A short description of the app provided by the package
-	ShortDescription string `protobuf:"bytes,6,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// Available package categories
-	//
-	// A user-facing list of category names useful for creating richer user interfaces.
-	// Plugins can choose not to implement this
-	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	// The information required to uniquely
+	// identify an installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *AvailablePackageSummary) Reset() {
-	*x = AvailablePackageSummary{}
+func (x *GetInstalledPackageResourceStatusesRequest) Reset() {
+	*x = GetInstalledPackageResourceStatusesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,13 +1180,13 @@ func (x *AvailablePackageSummary) Reset() {
 	}
 }
 
-func (x *AvailablePackageSummary) String() string {
+func (x *GetInstalledPackageResourceStatusesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageSummary) ProtoMessage() {}
+func (*GetInstalledPackageResourceStatusesRequest) ProtoMessage() {}
 
-func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
+func (x *GetInstalledPackageResourceStatusesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,149 +1198,105 @@ func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageSummary.ProtoReflect.Descriptor instead.
-func (*AvailablePackageSummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstalledPackageResourceStatusesRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageResourceStatusesRequest) Descriptor() ([]byte, []int) {
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AvailablePackageSummary) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *GetInstalledPackageResourceStatusesRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.AvailablePackageRef
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-func (x *AvailablePackageSummary) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// GetInstalledPackageRevisionsRequest
+//
+// Request for GetInstalledPackageRevisions
+type GetInstalledPackageRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The information required to uniquely
+	// identify an installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
 }
 
-func (x *AvailablePackageSummary) GetLatestVersion() *PackageAppVersion {
-	if x != nil {
-		return x.LatestVersion
+func (x *GetInstalledPackageRevisionsRequest) Reset() {
+	*x = GetInstalledPackageRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AvailablePackageSummary) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
-	}
-	return ""
+func (x *GetInstalledPackageRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AvailablePackageSummary) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
+func (*GetInstalledPackageRevisionsRequest) ProtoMessage() {}
+
+func (x *GetInstalledPackageRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AvailablePackageSummary) GetShortDescription() string {
-	if x != nil {
-		return x.ShortDescription
-	}
-	return ""
+// Deprecated: Use GetInstalledPackageRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AvailablePackageSummary) GetCategories() []string {
+func (x *GetInstalledPackageRevisionsRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.Categories
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-// AvailablePackageDetail
+// RollbackInstalledPackageRequest
 //
-// An AvailablePackageDetail provides additional details required when
-// inspecting an individual package.
-type AvailablePackageDetail struct {
+// Request for RollbackInstalledPackage
+type RollbackInstalledPackageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package reference
-	//
-	// A reference uniquely identifying the package.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// Available package name
-	//
-	// The name of the available package
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Available version
-	//
-	// The version of the package and application.
-	Version *PackageAppVersion `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// the url of the package repository that contains this package
-	RepoUrl string `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
-	// the url of the “home” for the package
-	HomeUrl string `protobuf:"bytes,5,opt,name=home_url,json=homeUrl,proto3" json:"home_url,omitempty"`
-	// Available package icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// Available package display name
-	//
-	// A name as displayed to users
-	DisplayName string `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Available package short description
-	//
-	// A short description of the app provided by the package
-	ShortDescription string `protobuf:"bytes,8,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// Available package long description
-	//
-	// A longer description of the package, a few sentences.
-	LongDescription string `protobuf:"bytes,9,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
-	// Available package readme
-	//
-	// A longer README with potentially pages of formatted Markdown.
-	Readme string `protobuf:"bytes,10,opt,name=readme,proto3" json:"readme,omitempty"`
-	// Available package default values
-	//
-	// An example of default values used during package templating that can serve
-	// as documentation or a starting point for user customization.
-	DefaultValues string `protobuf:"bytes,11,opt,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
-	ValuesSchema  string `protobuf:"bytes,12,opt,name=values_schema,json=valuesSchema,proto3" json:"values_schema,omitempty"`
-	// source urls for the package
-	SourceUrls []string `protobuf:"bytes,13,rep,name=source_urls,json=sourceUrls,proto3" json:"source_urls,omitempty"`
-	// Available package maintainers
-	//
-	// List of Maintainer
-	Maintainers []*Maintainer `protobuf:"bytes,14,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
-	// Available package categories
-	//
-	// A user-facing list of category names useful for creating richer user interfaces.
-	// Plugins can choose not to implement this
-	Categories []string `protobuf:"bytes,15,rep,name=categories,proto3" json:"categories,omitempty"`
-	// Custom data added by the plugin
+	// The information required to uniquely
+	// identify an installed package
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Revision
 	//
-	// A plugin can define custom details for data which is not yet, or never will
-	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-	// of an `Any` field means that each plugin can define the structure of this
-	// message as required, while still satisfying the core interface.
-	// See https://developers.google.com/protocol-buffers/docs/proto3#any
-	CustomDetail *anypb.Any `protobuf:"bytes,16,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// The revision of the installed package to roll back to, as returned by
+	// GetInstalledPackageRevisions.
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *AvailablePackageDetail) Reset() {
-	*x = AvailablePackageDetail{}
+func (x *RollbackInstalledPackageRequest) Reset() {
+	*x = RollbackInstalledPackageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AvailablePackageDetail) String() string {
+func (x *RollbackInstalledPackageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageDetail) ProtoMessage() {}
+func (*RollbackInstalledPackageRequest) ProtoMessage() {}
 
-func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13]
+func (x *RollbackInstalledPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,200 +1307,2007 @@ func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageDetail.ProtoReflect.Descriptor instead.
-func (*AvailablePackageDetail) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RollbackInstalledPackageRequest.ProtoReflect.Descriptor instead.
+func (*RollbackInstalledPackageRequest) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AvailablePackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+func (x *RollbackInstalledPackageRequest) GetInstalledPackageRef() *InstalledPackageReference {
 	if x != nil {
-		return x.AvailablePackageRef
+		return x.InstalledPackageRef
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetName() string {
+func (x *RollbackInstalledPackageRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// GetAvailablePackageSummariesResponse
+//
+// Response for GetAvailablePackageSummaries
+type GetAvailablePackageSummariesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available packages summaries
+	//
+	// List of AvailablePackageSummary
+	AvailablePackageSummaries []*AvailablePackageSummary `protobuf:"bytes,1,rep,name=available_package_summaries,json=availablePackageSummaries,proto3" json:"available_package_summaries,omitempty"`
+	// Next page token
+	//
+	// This field represents the pagination token to retrieve the next page of
+	// results. If the value is "", it means no further results for the request.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Categories
+	//
+	// This optional field contains the distinct category names considering the FilterOptions.
+	Categories []string `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Plugin errors
+	//
+	// When the request is aggregated across plugins, this optional field contains
+	// the errors of any plugins which failed, or did not respond in time, so that
+	// the results of the remaining plugins can still be returned.
+	PluginErrors []*PluginError `protobuf:"bytes,4,rep,name=plugin_errors,json=pluginErrors,proto3" json:"plugin_errors,omitempty"`
+}
+
+func (x *GetAvailablePackageSummariesResponse) Reset() {
+	*x = GetAvailablePackageSummariesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailablePackageSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailablePackageSummariesResponse) ProtoMessage() {}
+
+func (x *GetAvailablePackageSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailablePackageSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAvailablePackageSummariesResponse) GetAvailablePackageSummaries() []*AvailablePackageSummary {
+	if x != nil {
+		return x.AvailablePackageSummaries
+	}
+	return nil
+}
+
+func (x *GetAvailablePackageSummariesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAvailablePackageSummariesResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetAvailablePackageSummariesResponse) GetPluginErrors() []*PluginError {
+	if x != nil {
+		return x.PluginErrors
+	}
+	return nil
+}
+
+// GetAvailablePackageDetailResponse
+//
+// Response for GetAvailablePackageDetail
+type GetAvailablePackageDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package detail
+	//
+	// The requested AvailablePackageDetail
+	AvailablePackageDetail *AvailablePackageDetail `protobuf:"bytes,1,opt,name=available_package_detail,json=availablePackageDetail,proto3" json:"available_package_detail,omitempty"`
+}
+
+func (x *GetAvailablePackageDetailResponse) Reset() {
+	*x = GetAvailablePackageDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailablePackageDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailablePackageDetailResponse) ProtoMessage() {}
+
+func (x *GetAvailablePackageDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailablePackageDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageDetailResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAvailablePackageDetailResponse) GetAvailablePackageDetail() *AvailablePackageDetail {
+	if x != nil {
+		return x.AvailablePackageDetail
+	}
+	return nil
+}
+
+// GetAvailablePackageVersionsResponse
+//
+// Response for GetAvailablePackageVersions
+type GetAvailablePackageVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Package app versions
+	//
+	// By default (when version_query is empty or ignored) the response
+	// should contain an ordered summary of versions including the most recent three
+	// patch versions of the most recent three minor versions of the most recent three
+	// major versions when available, something like:
+	// [
+	//   { pkg_version: "10.3.19", app_version: "2.16.8" },
+	//   { pkg_version: "10.3.18", app_version: "2.16.8" },
+	//   { pkg_version: "10.3.17", app_version: "2.16.7" },
+	//   { pkg_version: "10.2.6", app_version: "2.15.3" },
+	//   { pkg_version: "10.2.5", app_version: "2.15.2" },
+	//   { pkg_version: "10.2.4", app_version: "2.15.2" },
+	//   { pkg_version: "10.1.8", app_version: "2.13.5" },
+	//   { pkg_version: "10.1.7", app_version: "2.13.5" },
+	//   { pkg_version: "10.1.6", app_version: "2.13.5" },
+	//   { pkg_version: "9.5.4", app_version: "2.8.9" },
+	//   ...
+	//   { pkg_version: "8.2.5", app_version: "1.19.5" },
+	//   ...
+	// ]
+	// If a version_query is present and the plugin chooses to support it,
+	// the full history of versions matching the version query should be returned.
+	PackageAppVersions []*PackageAppVersion `protobuf:"bytes,1,rep,name=package_app_versions,json=packageAppVersions,proto3" json:"package_app_versions,omitempty"`
+}
+
+func (x *GetAvailablePackageVersionsResponse) Reset() {
+	*x = GetAvailablePackageVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailablePackageVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailablePackageVersionsResponse) ProtoMessage() {}
+
+func (x *GetAvailablePackageVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailablePackageVersionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailablePackageVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAvailablePackageVersionsResponse) GetPackageAppVersions() []*PackageAppVersion {
+	if x != nil {
+		return x.PackageAppVersions
+	}
+	return nil
+}
+
+// GetInstalledPackageSummariesResponse
+//
+// Response for GetInstalledPackageSummaries
+type GetInstalledPackageSummariesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Installed packages summaries
+	//
+	// List of InstalledPackageSummary
+	InstalledPackageSummaries []*InstalledPackageSummary `protobuf:"bytes,1,rep,name=installed_package_summaries,json=installedPackageSummaries,proto3" json:"installed_package_summaries,omitempty"`
+	// Next page token
+	//
+	// This field represents the pagination token to retrieve the next page of
+	// results. If the value is "", it means no further results for the request.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Plugin errors
+	//
+	// When the request is aggregated across plugins, this optional field contains
+	// the errors of any plugins which failed, or did not respond in time, so that
+	// the results of the remaining plugins can still be returned.
+	PluginErrors []*PluginError `protobuf:"bytes,3,rep,name=plugin_errors,json=pluginErrors,proto3" json:"plugin_errors,omitempty"`
+}
+
+func (x *GetInstalledPackageSummariesResponse) Reset() {
+	*x = GetInstalledPackageSummariesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageSummariesResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetInstalledPackageSummariesResponse) GetInstalledPackageSummaries() []*InstalledPackageSummary {
+	if x != nil {
+		return x.InstalledPackageSummaries
+	}
+	return nil
+}
+
+func (x *GetInstalledPackageSummariesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetInstalledPackageSummariesResponse) GetPluginErrors() []*PluginError {
+	if x != nil {
+		return x.PluginErrors
+	}
+	return nil
+}
+
+// GetInstalledPackageDetailResponse
+//
+// Response for GetInstalledPackageDetail
+type GetInstalledPackageDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// InstalledPackageDetail
+	//
+	// The requested InstalledPackageDetail
+	InstalledPackageDetail *InstalledPackageDetail `protobuf:"bytes,1,opt,name=installed_package_detail,json=installedPackageDetail,proto3" json:"installed_package_detail,omitempty"`
+}
+
+func (x *GetInstalledPackageDetailResponse) Reset() {
+	*x = GetInstalledPackageDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageDetailResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageDetailResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetInstalledPackageDetailResponse) GetInstalledPackageDetail() *InstalledPackageDetail {
+	if x != nil {
+		return x.InstalledPackageDetail
+	}
+	return nil
+}
+
+// CreateInstalledPackageResponse
+//
+// Response for CreateInstalledPackage
+type CreateInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *CreateInstalledPackageResponse) Reset() {
+	*x = CreateInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstalledPackageResponse) ProtoMessage() {}
+
+func (x *CreateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*CreateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// RenderInstalledPackageResponse
+//
+// Response for RenderInstalledPackage
+type RenderInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Manifest
+	//
+	// The rendered manifest, as a multi-document YAML, which would be applied
+	// when creating the installed package.
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Resource references
+	//
+	// The references to the Kubernetes resources in the rendered manifest.
+	ResourceRefs []*ResourceRef `protobuf:"bytes,2,rep,name=resource_refs,json=resourceRefs,proto3" json:"resource_refs,omitempty"`
+}
+
+func (x *RenderInstalledPackageResponse) Reset() {
+	*x = RenderInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderInstalledPackageResponse) ProtoMessage() {}
+
+func (x *RenderInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*RenderInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RenderInstalledPackageResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *RenderInstalledPackageResponse) GetResourceRefs() []*ResourceRef {
+	if x != nil {
+		return x.ResourceRefs
+	}
+	return nil
+}
+
+// DiffInstalledPackageResponse
+//
+// Response for DiffInstalledPackage
+type DiffInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource diffs
+	//
+	// The resources which would be added, removed or changed by the update.
+	// Unchanged resources are not included.
+	ResourceDiffs []*ResourceDiff `protobuf:"bytes,1,rep,name=resource_diffs,json=resourceDiffs,proto3" json:"resource_diffs,omitempty"`
+}
+
+func (x *DiffInstalledPackageResponse) Reset() {
+	*x = DiffInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffInstalledPackageResponse) ProtoMessage() {}
+
+func (x *DiffInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*DiffInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiffInstalledPackageResponse) GetResourceDiffs() []*ResourceDiff {
+	if x != nil {
+		return x.ResourceDiffs
+	}
+	return nil
+}
+
+// UpdateInstalledPackageResponse
+//
+// Response for UpdateInstalledPackage
+type UpdateInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *UpdateInstalledPackageResponse) Reset() {
+	*x = UpdateInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstalledPackageResponse) ProtoMessage() {}
+
+func (x *UpdateInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// DeleteInstalledPackageResponse
+//
+// Response for DeleteInstalledPackage
+type DeleteInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteInstalledPackageResponse) Reset() {
+	*x = DeleteInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstalledPackageResponse) ProtoMessage() {}
+
+func (x *DeleteInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{24}
+}
+
+// WatchInstalledPackagesResponse
+//
+// Response for WatchInstalledPackages, sent when the status of an installed
+// package is first seen and each time it changes.
+type WatchInstalledPackagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A reference uniquely identifying the installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// The current status of the installed package.
+	Status *InstalledPackageStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Deleted
+	//
+	// Whether the installed package has been deleted, in which case the status
+	// is the last one seen.
+	Deleted bool `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *WatchInstalledPackagesResponse) Reset() {
+	*x = WatchInstalledPackagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchInstalledPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInstalledPackagesResponse) ProtoMessage() {}
+
+func (x *WatchInstalledPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInstalledPackagesResponse.ProtoReflect.Descriptor instead.
+func (*WatchInstalledPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchInstalledPackagesResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *WatchInstalledPackagesResponse) GetStatus() *InstalledPackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *WatchInstalledPackagesResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// GetInstalledPackageResourceRefsResponse
+//
+// Response for GetInstalledPackageResourceRefs
+type GetInstalledPackageResourceRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource references
+	//
+	// The references to the Kubernetes resources created by the installed
+	// package.
+	ResourceRefs []*ResourceRef `protobuf:"bytes,1,rep,name=resource_refs,json=resourceRefs,proto3" json:"resource_refs,omitempty"`
+}
+
+func (x *GetInstalledPackageResourceRefsResponse) Reset() {
+	*x = GetInstalledPackageResourceRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageResourceRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageResourceRefsResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageResourceRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageResourceRefsResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageResourceRefsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetInstalledPackageResourceRefsResponse) GetResourceRefs() []*ResourceRef {
+	if x != nil {
+		return x.ResourceRefs
+	}
+	return nil
+}
+
+// GetInstalledPackageResourceStatusesResponse
+//
+// Response for GetInstalledPackageResourceStatuses
+type GetInstalledPackageResourceStatusesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource statuses
+	//
+	// The readiness of each of the Kubernetes resources created by the
+	// installed package, as seen with the credentials of the user.
+	ResourceStatuses []*ResourceStatus `protobuf:"bytes,1,rep,name=resource_statuses,json=resourceStatuses,proto3" json:"resource_statuses,omitempty"`
+}
+
+func (x *GetInstalledPackageResourceStatusesResponse) Reset() {
+	*x = GetInstalledPackageResourceStatusesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageResourceStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageResourceStatusesResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageResourceStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageResourceStatusesResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageResourceStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetInstalledPackageResourceStatusesResponse) GetResourceStatuses() []*ResourceStatus {
+	if x != nil {
+		return x.ResourceStatuses
+	}
+	return nil
+}
+
+// GetInstalledPackageRevisionsResponse
+//
+// Response for GetInstalledPackageRevisions
+type GetInstalledPackageRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Revisions
+	//
+	// The revisions of the installed package, from the latest to the oldest.
+	Revisions []*InstalledPackageRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetInstalledPackageRevisionsResponse) Reset() {
+	*x = GetInstalledPackageRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledPackageRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledPackageRevisionsResponse) ProtoMessage() {}
+
+func (x *GetInstalledPackageRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledPackageRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledPackageRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetInstalledPackageRevisionsResponse) GetRevisions() []*InstalledPackageRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// RollbackInstalledPackageResponse
+//
+// Response for RollbackInstalledPackage
+type RollbackInstalledPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reference to the rolled back installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+}
+
+func (x *RollbackInstalledPackageResponse) Reset() {
+	*x = RollbackInstalledPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackInstalledPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackInstalledPackageResponse) ProtoMessage() {}
+
+func (x *RollbackInstalledPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackInstalledPackageResponse.ProtoReflect.Descriptor instead.
+func (*RollbackInstalledPackageResponse) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RollbackInstalledPackageResponse) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+// AvailablePackageSummary
+//
+// An AvailablePackageSummary provides a summary of a package available for installation
+// useful when aggregating many available packages.
+type AvailablePackageSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package reference
+	//
+	// A reference uniquely identifying the package.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Available package name
+	//
+	// The name of the available package
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Latest available version
+	//
+	// The latest version available for this package. Often expected when viewing
+	// a summary of many available packages.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Available package Icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Available package display name
+	//
+	// A name as displayed to users
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Available package short description
+	//
+	// A short description of the app provided by the package
+	ShortDescription string `protobuf:"bytes,6,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// Available package categories
+	//
+	// A user-facing list of category names useful for creating richer user interfaces.
+	// Plugins can choose not to implement this
+	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *AvailablePackageSummary) Reset() {
+	*x = AvailablePackageSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageSummary) ProtoMessage() {}
+
+func (x *AvailablePackageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageSummary.ProtoReflect.Descriptor instead.
+func (*AvailablePackageSummary) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AvailablePackageSummary) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *AvailablePackageSummary) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageSummary) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// AvailablePackageDetail
+//
+// An AvailablePackageDetail provides additional details required when
+// inspecting an individual package.
+type AvailablePackageDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package reference
+	//
+	// A reference uniquely identifying the package.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,1,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// Available package name
+	//
+	// The name of the available package
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Available version
+	//
+	// The version of the package and application.
+	Version *PackageAppVersion `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// the url of the package repository that contains this package
+	RepoUrl string `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// the url of the “home” for the package
+	HomeUrl string `protobuf:"bytes,5,opt,name=home_url,json=homeUrl,proto3" json:"home_url,omitempty"`
+	// Available package icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// Available package display name
+	//
+	// A name as displayed to users
+	DisplayName string `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Available package short description
+	//
+	// A short description of the app provided by the package
+	ShortDescription string `protobuf:"bytes,8,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// Available package long description
+	//
+	// A longer description of the package, a few sentences.
+	LongDescription string `protobuf:"bytes,9,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+	// Available package readme
+	//
+	// A longer README with potentially pages of formatted Markdown.
+	Readme string `protobuf:"bytes,10,opt,name=readme,proto3" json:"readme,omitempty"`
+	// Available package default values
+	//
+	// An example of default values used during package templating that can serve
+	// as documentation or a starting point for user customization.
+	DefaultValues string `protobuf:"bytes,11,opt,name=default_values,json=defaultValues,proto3" json:"default_values,omitempty"`
+	ValuesSchema  string `protobuf:"bytes,12,opt,name=values_schema,json=valuesSchema,proto3" json:"values_schema,omitempty"`
+	// source urls for the package
+	SourceUrls []string `protobuf:"bytes,13,rep,name=source_urls,json=sourceUrls,proto3" json:"source_urls,omitempty"`
+	// Available package maintainers
+	//
+	// List of Maintainer
+	Maintainers []*Maintainer `protobuf:"bytes,14,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	// Available package categories
+	//
+	// A user-facing list of category names useful for creating richer user interfaces.
+	// Plugins can choose not to implement this
+	Categories []string `protobuf:"bytes,15,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Custom data added by the plugin
+	//
+	// A plugin can define custom details for data which is not yet, or never will
+	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+	// of an `Any` field means that each plugin can define the structure of this
+	// message as required, while still satisfying the core interface.
+	// See https://developers.google.com/protocol-buffers/docs/proto3#any
+	CustomDetail *anypb.Any `protobuf:"bytes,16,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+}
+
+func (x *AvailablePackageDetail) Reset() {
+	*x = AvailablePackageDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageDetail) ProtoMessage() {}
+
+func (x *AvailablePackageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageDetail.ProtoReflect.Descriptor instead.
+func (*AvailablePackageDetail) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AvailablePackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetVersion() *PackageAppVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetHomeUrl() string {
+	if x != nil {
+		return x.HomeUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetLongDescription() string {
+	if x != nil {
+		return x.LongDescription
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetReadme() string {
+	if x != nil {
+		return x.Readme
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetDefaultValues() string {
+	if x != nil {
+		return x.DefaultValues
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetValuesSchema() string {
+	if x != nil {
+		return x.ValuesSchema
+	}
+	return ""
+}
+
+func (x *AvailablePackageDetail) GetSourceUrls() []string {
+	if x != nil {
+		return x.SourceUrls
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetMaintainers() []*Maintainer {
+	if x != nil {
+		return x.Maintainers
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *AvailablePackageDetail) GetCustomDetail() *anypb.Any {
+	if x != nil {
+		return x.CustomDetail
+	}
+	return nil
+}
+
+// InstalledPackageSummary
+//
+// An InstalledPackageSummary provides a summary of an installed package
+// useful when aggregating many installed packages.
+type InstalledPackageSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// InstalledPackageReference
+	//
+	// A reference uniquely identifying the package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// Name
+	//
+	// A name given to the installation of the package (eg. "my-postgresql-for-testing").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// PkgVersionReference
+	//
+	// The package version reference defines a version or constraint limiting
+	// matching package versions.
+	PkgVersionReference *VersionReference `protobuf:"bytes,3,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// CurrentVersion
+	//
+	// The current version of the package being reconciled, which may be
+	// in one of these states:
+	//  - has been successfully installed/upgraded or
+	//  - is currently being installed/upgraded or
+	//  - has failed to install/upgrade
+	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Installed package icon URL
+	//
+	// A url for an icon.
+	IconUrl string `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// PackageDisplayName
+	//
+	// The package name as displayed to users (provided by the package, eg. "PostgreSQL")
+	PkgDisplayName string `protobuf:"bytes,6,opt,name=pkg_display_name,json=pkgDisplayName,proto3" json:"pkg_display_name,omitempty"`
+	// ShortDescription
+	//
+	// A short description of the package (provided by the package)
+	ShortDescription string `protobuf:"bytes,7,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	// LatestMatchingVersion
+	//
+	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
+	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+	// is installed, then:
+	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,8,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
+	// LatestVersion
+	//
+	// The latest version available for this package, regardless of the pkg_version_reference.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,9,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Status
+	//
+	// The current status of the installed package.
+	Status *InstalledPackageStatus `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *InstalledPackageSummary) Reset() {
+	*x = InstalledPackageSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageSummary) ProtoMessage() {}
+
+func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageSummary.ProtoReflect.Descriptor instead.
+func (*InstalledPackageSummary) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InstalledPackageSummary) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetCurrentVersion() *PackageAppVersion {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetPkgDisplayName() string {
+	if x != nil {
+		return x.PkgDisplayName
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *InstalledPackageSummary) GetLatestMatchingVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestMatchingVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageSummary) GetStatus() *InstalledPackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// InstalledPackageDetail
+//
+// An InstalledPackageDetail includes details about the installed package that are
+// typically useful when presenting a single installed package.
+type InstalledPackageDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// InstalledPackageReference
+	//
+	// A reference uniquely identifying the installed package.
+	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
+	// PkgVersionReference
+	//
+	// The package version reference defines a version or constraint limiting
+	// matching package versions.
+	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
+	// Installed package name
+	//
+	// The name given to the installed package
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// CurrentVersion
+	//
+	// The version of the package which is currently installed.
+	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// ValuesApplied
+	//
+	// The values applied currently for the installed package.
+	ValuesApplied string `protobuf:"bytes,5,opt,name=values_applied,json=valuesApplied,proto3" json:"values_applied,omitempty"`
+	// ReconciliationOptions
+	//
+	// An optional field specifying data common to systems that reconcile
+	// the package installation on the cluster asynchronously. In particular,
+	// this specifies the service account used to perform the reconcilliation.
+	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
+	// Status
+	//
+	// The current status of the installed package.
+	Status *InstalledPackageStatus `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// PostInstallationNotes
+	//
+	// Optional notes generated by package and intended for the user post installation.
+	PostInstallationNotes string `protobuf:"bytes,8,opt,name=post_installation_notes,json=postInstallationNotes,proto3" json:"post_installation_notes,omitempty"`
+	// Available package reference
+	//
+	// A reference to the available package for this installation.
+	// Useful to lookup the package display name, icon and other info.
+	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,9,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
+	// LatestMatchingVersion
+	//
+	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
+	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
+	// is installed, then:
+	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
+	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
+	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,10,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
+	// LatestVersion
+	//
+	// The latest version available for this package, regardless of the pkg_version_reference.
+	LatestVersion *PackageAppVersion `protobuf:"bytes,11,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Custom data added by the plugin
+	//
+	// A plugin can define custom details for data which is not yet, or never will
+	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
+	// of an `Any` field means that each plugin can define the structure of this
+	// message as required, while still satisfying the core interface.
+	// See https://developers.google.com/protocol-buffers/docs/proto3#any
+	CustomDetail *anypb.Any `protobuf:"bytes,14,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// ValuesOrigins
+	//
+	// An optional list of the origin of each of the values resolved, for plugins
+	// which merge the values of an installed package from several sources.
+	// Values from sources which the user is not permitted to read are left out
+	// of values_resolved and only reported here as redacted.
+	ValuesOrigins []*ValueOrigin `protobuf:"bytes,15,rep,name=values_origins,json=valuesOrigins,proto3" json:"values_origins,omitempty"`
+	// ValuesResolvedPartial
+	//
+	// Set when values_resolved leaves out the values of some of the sources of
+	// the installed package, either because the user is not permitted to read
+	// them or because they could not be found, so that it differs from the
+	// values actually applied.
+	ValuesResolvedPartial bool `protobuf:"varint,16,opt,name=values_resolved_partial,json=valuesResolvedPartial,proto3" json:"values_resolved_partial,omitempty"`
+	// ValuesResolved
+	//
+	// An optional merge of the values of all the sources of the installed
+	// package, for plugins which merge its values from several sources. Unlike
+	// values_applied, which only has the values of the installed package itself
+	// and is what an update of the installed package replaces, it may include
+	// values read from secrets, so it must not be sent back in an update.
+	ValuesResolved string `protobuf:"bytes,17,opt,name=values_resolved,json=valuesResolved,proto3" json:"values_resolved,omitempty"`
+}
+
+func (x *InstalledPackageDetail) Reset() {
+	*x = InstalledPackageDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledPackageDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageDetail) ProtoMessage() {}
+
+func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageDetail.ProtoReflect.Descriptor instead.
+func (*InstalledPackageDetail) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InstalledPackageDetail) GetInstalledPackageRef() *InstalledPackageReference {
+	if x != nil {
+		return x.InstalledPackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetPkgVersionReference() *VersionReference {
+	if x != nil {
+		return x.PkgVersionReference
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetName() string {
 	if x != nil {
 		return x.Name
 	}
-	return ""
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetCurrentVersion() *PackageAppVersion {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetValuesApplied() string {
+	if x != nil {
+		return x.ValuesApplied
+	}
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetReconciliationOptions() *ReconciliationOptions {
+	if x != nil {
+		return x.ReconciliationOptions
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetStatus() *InstalledPackageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetPostInstallationNotes() string {
+	if x != nil {
+		return x.PostInstallationNotes
+	}
+	return ""
+}
+
+func (x *InstalledPackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
+	if x != nil {
+		return x.AvailablePackageRef
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetLatestMatchingVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestMatchingVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetLatestVersion() *PackageAppVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+func (x *InstalledPackageDetail) GetCustomDetail() *anypb.Any {
+	if x != nil {
+		return x.CustomDetail
+	}
+	return nil
 }
 
-func (x *AvailablePackageDetail) GetVersion() *PackageAppVersion {
+func (x *InstalledPackageDetail) GetValuesOrigins() []*ValueOrigin {
 	if x != nil {
-		return x.Version
+		return x.ValuesOrigins
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetRepoUrl() string {
+func (x *InstalledPackageDetail) GetValuesResolvedPartial() bool {
 	if x != nil {
-		return x.RepoUrl
+		return x.ValuesResolvedPartial
+	}
+	return false
+}
+
+func (x *InstalledPackageDetail) GetValuesResolved() string {
+	if x != nil {
+		return x.ValuesResolved
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetHomeUrl() string {
+// Context
+//
+// A Context specifies the context of the message
+type Context struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cluster
+	//
+	// A cluster name can be provided to target a specific cluster if multiple
+	// clusters are configured, otherwise all clusters will be assumed.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Namespace
+	//
+	// A namespace must be provided if the context of the operation is for a resource
+	// or resources in a particular namespace.
+	// For requests to list items, not including a namespace here implies that the context
+	// for the request is everything the requesting user can read, though the result can
+	// be filtered by any filtering options of the request. Plugins may choose to return
+	// Unimplemented for some queries for which we do not yet have a need.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Context) Reset() {
+	*x = Context{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context) ProtoMessage() {}
+
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Context) GetCluster() string {
 	if x != nil {
-		return x.HomeUrl
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetIconUrl() string {
+func (x *Context) GetNamespace() string {
 	if x != nil {
-		return x.IconUrl
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetDisplayName() string {
+// AvailablePackageReference
+//
+// An AvailablePackageReference has the minimum information required to uniquely
+// identify an available package. This is re-used on the summary and details of an
+// available package.
+type AvailablePackageReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Available package context
+	//
+	// The context (cluster/namespace) for the package.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// Available package identifier
+	//
+	// The fully qualified identifier for the available package
+	// (ie. a unique name for the context). For some packaging systems
+	// (particularly those where an available package is backed by a CR) this
+	// will just be the name, but for others such as those where an available
+	// package is not backed by a CR (eg. standard helm) it may be necessary
+	// to include the repository in the name or even the repo namespace
+	// to ensure this is unique.
+	// For example two helm repositories can define
+	// an "apache" chart that is available globally, the names would need to
+	// encode that to be unique (ie. "repoA:apache" and "repoB:apache").
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Plugin for the available package
+	//
+	// The plugin used to interact with this available package.
+	// This field should be omitted when the request is in the context of a specific plugin.
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *AvailablePackageReference) Reset() {
+	*x = AvailablePackageReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailablePackageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailablePackageReference) ProtoMessage() {}
+
+func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailablePackageReference.ProtoReflect.Descriptor instead.
+func (*AvailablePackageReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AvailablePackageReference) GetContext() *Context {
 	if x != nil {
-		return x.DisplayName
+		return x.Context
+	}
+	return nil
+}
+
+func (x *AvailablePackageReference) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetShortDescription() string {
+func (x *AvailablePackageReference) GetPlugin() *v1alpha1.Plugin {
 	if x != nil {
-		return x.ShortDescription
+		return x.Plugin
+	}
+	return nil
+}
+
+// Maintainer
+//
+// Maintainers for the package.
+type Maintainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maintainer name
+	//
+	// A maintainer name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Maintainer email
+	//
+	// A maintainer email
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *Maintainer) Reset() {
+	*x = Maintainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maintainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maintainer) ProtoMessage() {}
+
+func (x *Maintainer) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maintainer.ProtoReflect.Descriptor instead.
+func (*Maintainer) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Maintainer) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetLongDescription() string {
+func (x *Maintainer) GetEmail() string {
 	if x != nil {
-		return x.LongDescription
+		return x.Email
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetReadme() string {
-	if x != nil {
-		return x.Readme
+// FilterOptions
+//
+// FilterOptions available when requesting summaries
+type FilterOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Text query
+	//
+	// Text query for the request
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Categories
+	//
+	// Collection of categories for the request
+	Categories []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Repositories
+	//
+	// Collection of repositories where the packages belong to
+	Repositories []string `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// Package version
+	//
+	// Package version for the request
+	PkgVersion string `protobuf:"bytes,4,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// App version
+	//
+	// Packaged app version for the request
+	AppVersion string `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (x *FilterOptions) Reset() {
+	*x = FilterOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterOptions) ProtoMessage() {}
+
+func (x *FilterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AvailablePackageDetail) GetDefaultValues() string {
-	if x != nil {
-		return x.DefaultValues
-	}
-	return ""
+// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
+func (*FilterOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AvailablePackageDetail) GetValuesSchema() string {
+func (x *FilterOptions) GetQuery() string {
 	if x != nil {
-		return x.ValuesSchema
+		return x.Query
 	}
 	return ""
 }
 
-func (x *AvailablePackageDetail) GetSourceUrls() []string {
+func (x *FilterOptions) GetCategories() []string {
 	if x != nil {
-		return x.SourceUrls
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetMaintainers() []*Maintainer {
+func (x *FilterOptions) GetRepositories() []string {
 	if x != nil {
-		return x.Maintainers
+		return x.Repositories
 	}
 	return nil
 }
 
-func (x *AvailablePackageDetail) GetCategories() []string {
+func (x *FilterOptions) GetPkgVersion() string {
 	if x != nil {
-		return x.Categories
+		return x.PkgVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *AvailablePackageDetail) GetCustomDetail() *anypb.Any {
+func (x *FilterOptions) GetAppVersion() string {
 	if x != nil {
-		return x.CustomDetail
+		return x.AppVersion
 	}
-	return nil
+	return ""
 }
 
-// InstalledPackageSummary
+// PaginationOptions
 //
-// An InstalledPackageSummary provides a summary of an installed package
-// useful when aggregating many installed packages.
-type InstalledPackageSummary struct {
+// The PaginationOptions based on the example proto at:
+// https://cloud.google.com/apis/design/design_patterns#list_pagination
+// just encapsulated in a message so it can be reused on different request messages.
+type PaginationOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// InstalledPackageReference
-	//
-	// A reference uniquely identifying the package.
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
-	// Name
-	//
-	// A name given to the installation of the package (eg. "my-postgresql-for-testing").
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// PkgVersionReference
-	//
-	// The package version reference defines a version or constraint limiting
-	// matching package versions.
-	PkgVersionReference *VersionReference `protobuf:"bytes,3,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// CurrentVersion
-	//
-	// The current version of the package being reconciled, which may be
-	// in one of these states:
-	//  - has been successfully installed/upgraded or
-	//  - is currently being installed/upgraded or
-	//  - has failed to install/upgrade
-	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
-	// Installed package icon URL
-	//
-	// A url for an icon.
-	IconUrl string `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	// PackageDisplayName
-	//
-	// The package name as displayed to users (provided by the package, eg. "PostgreSQL")
-	PkgDisplayName string `protobuf:"bytes,6,opt,name=pkg_display_name,json=pkgDisplayName,proto3" json:"pkg_display_name,omitempty"`
-	// ShortDescription
-	//
-	// A short description of the package (provided by the package)
-	ShortDescription string `protobuf:"bytes,7,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	// LatestMatchingVersion
-	//
-	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
-	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-	// is installed, then:
-	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
-	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,8,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
-	// LatestVersion
+	// Page token
 	//
-	// The latest version available for this package, regardless of the pkg_version_reference.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,9,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Status
+	// The client uses this field to request a specific page of the list results.
+	// Clients should treat the token as opaque: the core API returns a token
+	// combining a cursor for each plugin, while each plugin returns the offset
+	// of the next item in its own results.
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Page size
 	//
-	// The current status of the installed package.
-	Status *InstalledPackageStatus `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Clients use this field to specify the maximum number of results to be
+	// returned by the server. The server may further constrain the maximum number
+	// of results returned in a single page. If the page_size is 0, the server
+	// will decide the number of results to be returned.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *InstalledPackageSummary) Reset() {
-	*x = InstalledPackageSummary{}
+func (x *PaginationOptions) Reset() {
+	*x = PaginationOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageSummary) String() string {
+func (x *PaginationOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageSummary) ProtoMessage() {}
+func (*PaginationOptions) ProtoMessage() {}
 
-func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14]
+func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,169 +3318,130 @@ func (x *InstalledPackageSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageSummary.ProtoReflect.Descriptor instead.
-func (*InstalledPackageSummary) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *InstalledPackageSummary) GetInstalledPackageRef() *InstalledPackageReference {
-	if x != nil {
-		return x.InstalledPackageRef
-	}
-	return nil
+// Deprecated: Use PaginationOptions.ProtoReflect.Descriptor instead.
+func (*PaginationOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *InstalledPackageSummary) GetName() string {
+func (x *PaginationOptions) GetPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *InstalledPackageSummary) GetPkgVersionReference() *VersionReference {
+func (x *PaginationOptions) GetPageSize() int32 {
 	if x != nil {
-		return x.PkgVersionReference
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *InstalledPackageSummary) GetCurrentVersion() *PackageAppVersion {
-	if x != nil {
-		return x.CurrentVersion
-	}
-	return nil
+// SortOptions
+//
+// The SortOptions specify the order in which results are returned, and are
+// honored both by each plugin and when the results of several plugins are
+// merged.
+type SortOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Field
+	//
+	// The field by which the results are sorted.
+	Field SortOptions_SortField `protobuf:"varint,1,opt,name=field,proto3,enum=kubeappsapis.core.packages.v1alpha1.SortOptions_SortField" json:"field,omitempty"`
+	// Descending
+	//
+	// Whether the results are sorted in descending, rather than ascending, order
+	// of the field.
+	Descending bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *InstalledPackageSummary) GetIconUrl() string {
-	if x != nil {
-		return x.IconUrl
+func (x *SortOptions) Reset() {
+	*x = SortOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *InstalledPackageSummary) GetPkgDisplayName() string {
-	if x != nil {
-		return x.PkgDisplayName
-	}
-	return ""
+func (x *SortOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InstalledPackageSummary) GetShortDescription() string {
-	if x != nil {
-		return x.ShortDescription
+func (*SortOptions) ProtoMessage() {}
+
+func (x *SortOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledPackageSummary) GetLatestMatchingVersion() *PackageAppVersion {
-	if x != nil {
-		return x.LatestMatchingVersion
-	}
-	return nil
+// Deprecated: Use SortOptions.ProtoReflect.Descriptor instead.
+func (*SortOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *InstalledPackageSummary) GetLatestVersion() *PackageAppVersion {
+func (x *SortOptions) GetField() SortOptions_SortField {
 	if x != nil {
-		return x.LatestVersion
+		return x.Field
 	}
-	return nil
+	return SortOptions_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *InstalledPackageSummary) GetStatus() *InstalledPackageStatus {
+func (x *SortOptions) GetDescending() bool {
 	if x != nil {
-		return x.Status
+		return x.Descending
 	}
-	return nil
+	return false
 }
 
-// InstalledPackageDetail
+// InstalledPackageReference
 //
-// An InstalledPackageDetail includes details about the installed package that are
-// typically useful when presenting a single installed package.
-type InstalledPackageDetail struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// InstalledPackageReference
-	//
-	// A reference uniquely identifying the installed package.
-	InstalledPackageRef *InstalledPackageReference `protobuf:"bytes,1,opt,name=installed_package_ref,json=installedPackageRef,proto3" json:"installed_package_ref,omitempty"`
-	// PkgVersionReference
-	//
-	// The package version reference defines a version or constraint limiting
-	// matching package versions.
-	PkgVersionReference *VersionReference `protobuf:"bytes,2,opt,name=pkg_version_reference,json=pkgVersionReference,proto3" json:"pkg_version_reference,omitempty"`
-	// Installed package name
-	//
-	// The name given to the installed package
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// CurrentVersion
-	//
-	// The version of the package which is currently installed.
-	CurrentVersion *PackageAppVersion `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
-	// ValuesApplied
-	//
-	// The values applied currently for the installed package.
-	ValuesApplied string `protobuf:"bytes,5,opt,name=values_applied,json=valuesApplied,proto3" json:"values_applied,omitempty"`
-	// ReconciliationOptions
-	//
-	// An optional field specifying data common to systems that reconcile
-	// the package installation on the cluster asynchronously. In particular,
-	// this specifies the service account used to perform the reconcilliation.
-	ReconciliationOptions *ReconciliationOptions `protobuf:"bytes,6,opt,name=reconciliation_options,json=reconciliationOptions,proto3" json:"reconciliation_options,omitempty"`
-	// Status
-	//
-	// The current status of the installed package.
-	Status *InstalledPackageStatus `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	// PostInstallationNotes
-	//
-	// Optional notes generated by package and intended for the user post installation.
-	PostInstallationNotes string `protobuf:"bytes,8,opt,name=post_installation_notes,json=postInstallationNotes,proto3" json:"post_installation_notes,omitempty"`
-	// Available package reference
-	//
-	// A reference to the available package for this installation.
-	// Useful to lookup the package display name, icon and other info.
-	AvailablePackageRef *AvailablePackageReference `protobuf:"bytes,9,opt,name=available_package_ref,json=availablePackageRef,proto3" json:"available_package_ref,omitempty"`
-	// LatestMatchingVersion
-	//
-	// Only non-empty if an available upgrade matches the specified pkg_version_reference.
-	// For example, if the pkg_version_reference is ">10.3.0 < 10.4.0" and 10.3.1
-	// is installed, then:
-	//   * if 10.3.2 is available, latest_matching_version should be 10.3.2, but
-	//   * if 10.4 is available while >10.3.1 is not, this should remain empty.
-	LatestMatchingVersion *PackageAppVersion `protobuf:"bytes,10,opt,name=latest_matching_version,json=latestMatchingVersion,proto3" json:"latest_matching_version,omitempty"`
-	// LatestVersion
-	//
-	// The latest version available for this package, regardless of the pkg_version_reference.
-	LatestVersion *PackageAppVersion `protobuf:"bytes,11,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	// Custom data added by the plugin
+// An InstalledPackageReference has the minimum information required to uniquely
+// identify an installed package.
+type InstalledPackageReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Installed package context
 	//
-	// A plugin can define custom details for data which is not yet, or never will
-	// be specified in the core.packaging.CreateInstalledPackageRequest fields. The use
-	// of an `Any` field means that each plugin can define the structure of this
-	// message as required, while still satisfying the core interface.
-	// See https://developers.google.com/protocol-buffers/docs/proto3#any
-	CustomDetail *anypb.Any `protobuf:"bytes,14,opt,name=custom_detail,json=customDetail,proto3" json:"custom_detail,omitempty"`
+	// The context (cluster/namespace) for the package.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The fully qualified identifier for the installed package
+	// (ie. a unique name for the context).
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// The plugin used to identify and interact with the installed package.
+	// This field can be omitted when the request is in the context of a specific plugin.
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
 }
 
-func (x *InstalledPackageDetail) Reset() {
-	*x = InstalledPackageDetail{}
+func (x *InstalledPackageReference) Reset() {
+	*x = InstalledPackageReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageDetail) String() string {
+func (x *InstalledPackageReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageDetail) ProtoMessage() {}
+func (*InstalledPackageReference) ProtoMessage() {}
 
-func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15]
+func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,136 +3452,207 @@ func (x *InstalledPackageDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageDetail.ProtoReflect.Descriptor instead.
-func (*InstalledPackageDetail) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *InstalledPackageDetail) GetInstalledPackageRef() *InstalledPackageReference {
-	if x != nil {
-		return x.InstalledPackageRef
-	}
-	return nil
+// Deprecated: Use InstalledPackageReference.ProtoReflect.Descriptor instead.
+func (*InstalledPackageReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *InstalledPackageDetail) GetPkgVersionReference() *VersionReference {
+func (x *InstalledPackageReference) GetContext() *Context {
 	if x != nil {
-		return x.PkgVersionReference
+		return x.Context
 	}
 	return nil
 }
 
-func (x *InstalledPackageDetail) GetName() string {
+func (x *InstalledPackageReference) GetIdentifier() string {
 	if x != nil {
-		return x.Name
+		return x.Identifier
 	}
 	return ""
 }
 
-func (x *InstalledPackageDetail) GetCurrentVersion() *PackageAppVersion {
+func (x *InstalledPackageReference) GetPlugin() *v1alpha1.Plugin {
 	if x != nil {
-		return x.CurrentVersion
+		return x.Plugin
 	}
 	return nil
 }
 
-func (x *InstalledPackageDetail) GetValuesApplied() string {
-	if x != nil {
-		return x.ValuesApplied
-	}
-	return ""
+// VersionReference
+//
+// A VersionReference defines a version or constraint limiting matching versions.
+// The reason it is a separate message is so that in the future we can add other
+// fields as necessary (such as something similar to Carvel's `prereleases` option
+// to its versionSelection).
+type VersionReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version
+	//
+	// The format of the version constraint depends on the backend. For example,
+	// for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *InstalledPackageDetail) GetReconciliationOptions() *ReconciliationOptions {
-	if x != nil {
-		return x.ReconciliationOptions
+func (x *VersionReference) Reset() {
+	*x = VersionReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InstalledPackageDetail) GetStatus() *InstalledPackageStatus {
-	if x != nil {
-		return x.Status
+func (x *VersionReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionReference) ProtoMessage() {}
+
+func (x *VersionReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InstalledPackageDetail) GetPostInstallationNotes() string {
+// Deprecated: Use VersionReference.ProtoReflect.Descriptor instead.
+func (*VersionReference) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VersionReference) GetVersion() string {
 	if x != nil {
-		return x.PostInstallationNotes
+		return x.Version
 	}
 	return ""
 }
 
-func (x *InstalledPackageDetail) GetAvailablePackageRef() *AvailablePackageReference {
-	if x != nil {
-		return x.AvailablePackageRef
+// InstalledPackageStatus
+//
+// An InstalledPackageStatus reports on the current status of the installation.
+type InstalledPackageStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ready
+	//
+	// An indication of whether the installation is ready or not
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Reason
+	//
+	// An enum indicating the reason for the current status.
+	Reason InstalledPackageStatus_StatusReason `protobuf:"varint,2,opt,name=reason,proto3,enum=kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus_StatusReason" json:"reason,omitempty"`
+	// UserReason
+	//
+	// Optional text to return for user context, which may be plugin specific.
+	UserReason string `protobuf:"bytes,3,opt,name=user_reason,json=userReason,proto3" json:"user_reason,omitempty"`
+}
+
+func (x *InstalledPackageStatus) Reset() {
+	*x = InstalledPackageStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InstalledPackageDetail) GetLatestMatchingVersion() *PackageAppVersion {
+func (x *InstalledPackageStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackageStatus) ProtoMessage() {}
+
+func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackageStatus.ProtoReflect.Descriptor instead.
+func (*InstalledPackageStatus) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InstalledPackageStatus) GetReady() bool {
 	if x != nil {
-		return x.LatestMatchingVersion
+		return x.Ready
 	}
-	return nil
+	return false
 }
 
-func (x *InstalledPackageDetail) GetLatestVersion() *PackageAppVersion {
+func (x *InstalledPackageStatus) GetReason() InstalledPackageStatus_StatusReason {
 	if x != nil {
-		return x.LatestVersion
+		return x.Reason
 	}
-	return nil
+	return InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
 }
 
-func (x *InstalledPackageDetail) GetCustomDetail() *anypb.Any {
+func (x *InstalledPackageStatus) GetUserReason() string {
 	if x != nil {
-		return x.CustomDetail
+		return x.UserReason
 	}
-	return nil
+	return ""
 }
 
-// Context
+// ReconciliationOptions
 //
-// A Context specifies the context of the message
-type Context struct {
+// ReconciliationOptions enable specifying standard fields for backends that continuously
+// reconcile a package install as new matching versions are released. Most of the naming
+// is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
+// InstalledPackage.
+type ReconciliationOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Cluster
+	// Reconciliation Interval
 	//
-	// A cluster name can be provided to target a specific cluster if multiple
-	// clusters are configured, otherwise all clusters will be assumed.
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Namespace
+	// The interval with which the package is checked for reconciliation (in seconds)
+	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Suspend
 	//
-	// A namespace must be provided if the context of the operation is for a resource
-	// or resources in a particular namespace.
-	// For requests to list items, not including a namespace here implies that the context
-	// for the request is everything the requesting user can read, though the result can
-	// be filtered by any filtering options of the request. Plugins may choose to return
-	// Unimplemented for some queries for which we do not yet have a need.
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Whether reconciliation should be suspended until otherwise enabled.
+	Suspend bool `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	// ServiceAccountName
+	//
+	// A name for a service account in the same namespace which should be used
+	// to perform the reconciliation.
+	ServiceAccountName string `protobuf:"bytes,3,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
 }
 
-func (x *Context) Reset() {
-	*x = Context{}
+func (x *ReconciliationOptions) Reset() {
+	*x = ReconciliationOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Context) String() string {
+func (x *ReconciliationOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context) ProtoMessage() {}
+func (*ReconciliationOptions) ProtoMessage() {}
 
-func (x *Context) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16]
+func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,76 +3663,67 @@ func (x *Context) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ReconciliationOptions.ProtoReflect.Descriptor instead.
+func (*ReconciliationOptions) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Context) GetCluster() string {
+func (x *ReconciliationOptions) GetInterval() int32 {
 	if x != nil {
-		return x.Cluster
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *Context) GetNamespace() string {
+func (x *ReconciliationOptions) GetSuspend() bool {
 	if x != nil {
-		return x.Namespace
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *ReconciliationOptions) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
 	}
 	return ""
 }
 
-// AvailablePackageReference
+// Package AppVersion
 //
-// An AvailablePackageReference has the minimum information required to uniquely
-// identify an available package. This is re-used on the summary and details of an
-// available package.
-type AvailablePackageReference struct {
+// PackageAppVersion conveys both the package version and the packaged app version.
+type PackageAppVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Available package context
-	//
-	// The context (cluster/namespace) for the package.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// Available package identifier
+	// Package version
 	//
-	// The fully qualified identifier for the available package
-	// (ie. a unique name for the context). For some packaging systems
-	// (particularly those where an available package is backed by a CR) this
-	// will just be the name, but for others such as those where an available
-	// package is not backed by a CR (eg. standard helm) it may be necessary
-	// to include the repository in the name or even the repo namespace
-	// to ensure this is unique.
-	// For example two helm repositories can define
-	// an "apache" chart that is available globally, the names would need to
-	// encode that to be unique (ie. "repoA:apache" and "repoB:apache").
-	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	// Plugin for the available package
+	// Version of the package itself
+	PkgVersion string `protobuf:"bytes,1,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
+	// Application version
 	//
-	// The plugin used to interact with this available package.
-	// This field should be omitted when the request is in the context of a specific plugin.
-	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Version of the packaged application
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
 }
 
-func (x *AvailablePackageReference) Reset() {
-	*x = AvailablePackageReference{}
+func (x *PackageAppVersion) Reset() {
+	*x = PackageAppVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AvailablePackageReference) String() string {
+func (x *PackageAppVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailablePackageReference) ProtoMessage() {}
+func (*PackageAppVersion) ProtoMessage() {}
 
-func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17]
+func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,67 +3734,65 @@ func (x *AvailablePackageReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailablePackageReference.ProtoReflect.Descriptor instead.
-func (*AvailablePackageReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *AvailablePackageReference) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use PackageAppVersion.ProtoReflect.Descriptor instead.
+func (*PackageAppVersion) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AvailablePackageReference) GetIdentifier() string {
+func (x *PackageAppVersion) GetPkgVersion() string {
 	if x != nil {
-		return x.Identifier
+		return x.PkgVersion
 	}
 	return ""
 }
 
-func (x *AvailablePackageReference) GetPlugin() *v1alpha1.Plugin {
+func (x *PackageAppVersion) GetAppVersion() string {
 	if x != nil {
-		return x.Plugin
+		return x.AppVersion
 	}
-	return nil
+	return ""
 }
 
-// Maintainer
+// PluginError
 //
-// Maintainers for the package.
-type Maintainer struct {
+// An error returned by a single plugin when a request is aggregated across
+// all the configured plugins.
+type PluginError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maintainer name
+	// Plugin
 	//
-	// A maintainer name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Maintainer email
+	// The plugin which returned the error.
+	Plugin *v1alpha1.Plugin `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Status code
 	//
-	// A maintainer email
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// The gRPC status code of the error returned by the plugin.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Message
+	//
+	// The error message returned by the plugin.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Maintainer) Reset() {
-	*x = Maintainer{}
+func (x *PluginError) Reset() {
+	*x = PluginError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Maintainer) String() string {
+func (x *PluginError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Maintainer) ProtoMessage() {}
+func (*PluginError) ProtoMessage() {}
 
-func (x *Maintainer) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18]
+func (x *PluginError) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,72 +3803,68 @@ func (x *Maintainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Maintainer.ProtoReflect.Descriptor instead.
-func (*Maintainer) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PluginError.ProtoReflect.Descriptor instead.
+func (*PluginError) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Maintainer) GetName() string {
+func (x *PluginError) GetPlugin() *v1alpha1.Plugin {
 	if x != nil {
-		return x.Name
+		return x.Plugin
 	}
-	return ""
+	return nil
 }
 
-func (x *Maintainer) GetEmail() string {
+func (x *PluginError) GetStatusCode() int32 {
 	if x != nil {
-		return x.Email
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PluginError) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-// FilterOptions
+// ResourceRef
 //
-// FilterOptions available when requesting summaries
-type FilterOptions struct {
+// A reference to a Kubernetes resource created by an installed package.
+type ResourceRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Text query
-	//
-	// Text query for the request
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	// Categories
-	//
-	// Collection of categories for the request
-	Categories []string `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
-	// Repositories
-	//
-	// Collection of repositories where the packages belong to
-	Repositories []string `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
-	// Package version
-	//
-	// Package version for the request
-	PkgVersion string `protobuf:"bytes,4,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
-	// App version
-	//
-	// Packaged app version for the request
-	AppVersion string `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// The api version of the resource, in the group/version format, or empty
+	// when the plugin cannot determine it.
+	ApiVersion string `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The kind of the resource, such as Deployment.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The name of the resource.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The namespace of the resource, or empty for cluster-scoped resources.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func (x *FilterOptions) Reset() {
-	*x = FilterOptions{}
+func (x *ResourceRef) Reset() {
+	*x = ResourceRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterOptions) String() string {
+func (x *ResourceRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterOptions) ProtoMessage() {}
+func (*ResourceRef) ProtoMessage() {}
 
-func (x *FilterOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19]
+func (x *ResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,86 +3875,73 @@ func (x *FilterOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterOptions.ProtoReflect.Descriptor instead.
-func (*FilterOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ResourceRef.ProtoReflect.Descriptor instead.
+func (*ResourceRef) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *FilterOptions) GetQuery() string {
+func (x *ResourceRef) GetApiVersion() string {
 	if x != nil {
-		return x.Query
+		return x.ApiVersion
 	}
 	return ""
 }
 
-func (x *FilterOptions) GetCategories() []string {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-func (x *FilterOptions) GetRepositories() []string {
+func (x *ResourceRef) GetKind() string {
 	if x != nil {
-		return x.Repositories
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *FilterOptions) GetPkgVersion() string {
+func (x *ResourceRef) GetName() string {
 	if x != nil {
-		return x.PkgVersion
+		return x.Name
 	}
 	return ""
 }
 
-func (x *FilterOptions) GetAppVersion() string {
+func (x *ResourceRef) GetNamespace() string {
 	if x != nil {
-		return x.AppVersion
+		return x.Namespace
 	}
 	return ""
 }
 
-// PaginationOptions
+// ResourceStatus
 //
-// The PaginationOptions based on the example proto at:
-// https://cloud.google.com/apis/design/design_patterns#list_pagination
-// just encapsulated in a message so it can be reused on different request messages.
-type PaginationOptions struct {
+// The readiness of a Kubernetes resource created by an installed package.
+type ResourceStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Page token
-	//
-	// The client uses this field to request a specific page of the list results.
-	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Page size
-	//
-	// Clients use this field to specify the maximum number of results to be
-	// returned by the server. The server may further constrain the maximum number
-	// of results returned in a single page. If the page_size is 0, the server
-	// will decide the number of results to be returned.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A reference to the resource.
+	ResourceRef *ResourceRef `protobuf:"bytes,1,opt,name=resource_ref,json=resourceRef,proto3" json:"resource_ref,omitempty"`
+	// Whether the resource is ready.
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	// A short explanation when the resource is not ready, such as
+	// "2/3 replicas ready", or when its readiness could not be checked.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *PaginationOptions) Reset() {
-	*x = PaginationOptions{}
+func (x *ResourceStatus) Reset() {
+	*x = ResourceStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaginationOptions) String() string {
+func (x *ResourceStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationOptions) ProtoMessage() {}
+func (*ResourceStatus) ProtoMessage() {}
 
-func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20]
+func (x *ResourceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,63 +3952,66 @@ func (x *PaginationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationOptions.ProtoReflect.Descriptor instead.
-func (*PaginationOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ResourceStatus.ProtoReflect.Descriptor instead.
+func (*ResourceStatus) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *PaginationOptions) GetPageToken() string {
+func (x *ResourceStatus) GetResourceRef() *ResourceRef {
 	if x != nil {
-		return x.PageToken
+		return x.ResourceRef
 	}
-	return ""
+	return nil
 }
 
-func (x *PaginationOptions) GetPageSize() int32 {
+func (x *ResourceStatus) GetReady() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Ready
 	}
-	return 0
+	return false
 }
 
-// InstalledPackageReference
+func (x *ResourceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ValueSource
 //
-// An InstalledPackageReference has the minimum information required to uniquely
-// identify an installed package.
-type InstalledPackageReference struct {
+// A source of values for an installed package. The values of each source are
+// serialized in the format expected by the plugin, such as YAML for helm.
+type ValueSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Installed package context
-	//
-	// The context (cluster/namespace) for the package.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// The fully qualified identifier for the installed package
-	// (ie. a unique name for the context).
-	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	// The plugin used to identify and interact with the installed package.
-	// This field can be omitted when the request is in the context of a specific plugin.
-	Plugin *v1alpha1.Plugin `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Types that are assignable to Source:
+	//	*ValueSource_Inline
+	//	*ValueSource_ConfigMapKeyRef
+	//	*ValueSource_SecretKeyRef
+	//	*ValueSource_Preset
+	Source isValueSource_Source `protobuf_oneof:"source"`
 }
 
-func (x *InstalledPackageReference) Reset() {
-	*x = InstalledPackageReference{}
+func (x *ValueSource) Reset() {
+	*x = ValueSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageReference) String() string {
+func (x *ValueSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageReference) ProtoMessage() {}
+func (*ValueSource) ProtoMessage() {}
 
-func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21]
+func (x *ValueSource) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,67 +4022,110 @@ func (x *InstalledPackageReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageReference.ProtoReflect.Descriptor instead.
-func (*InstalledPackageReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ValueSource.ProtoReflect.Descriptor instead.
+func (*ValueSource) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *InstalledPackageReference) GetContext() *Context {
-	if x != nil {
-		return x.Context
+func (m *ValueSource) GetSource() isValueSource_Source {
+	if m != nil {
+		return m.Source
 	}
 	return nil
 }
 
-func (x *InstalledPackageReference) GetIdentifier() string {
-	if x != nil {
-		return x.Identifier
+func (x *ValueSource) GetInline() string {
+	if x, ok := x.GetSource().(*ValueSource_Inline); ok {
+		return x.Inline
 	}
 	return ""
 }
 
-func (x *InstalledPackageReference) GetPlugin() *v1alpha1.Plugin {
-	if x != nil {
-		return x.Plugin
+func (x *ValueSource) GetConfigMapKeyRef() *ValueSourceKeyRef {
+	if x, ok := x.GetSource().(*ValueSource_ConfigMapKeyRef); ok {
+		return x.ConfigMapKeyRef
 	}
 	return nil
 }
 
-// VersionReference
+func (x *ValueSource) GetSecretKeyRef() *ValueSourceKeyRef {
+	if x, ok := x.GetSource().(*ValueSource_SecretKeyRef); ok {
+		return x.SecretKeyRef
+	}
+	return nil
+}
+
+func (x *ValueSource) GetPreset() string {
+	if x, ok := x.GetSource().(*ValueSource_Preset); ok {
+		return x.Preset
+	}
+	return ""
+}
+
+type isValueSource_Source interface {
+	isValueSource_Source()
+}
+
+type ValueSource_Inline struct {
+	// Values provided inline.
+	Inline string `protobuf:"bytes,1,opt,name=inline,proto3,oneof"`
+}
+
+type ValueSource_ConfigMapKeyRef struct {
+	// A key of a ConfigMap in the target namespace containing the values.
+	ConfigMapKeyRef *ValueSourceKeyRef `protobuf:"bytes,2,opt,name=config_map_key_ref,json=configMapKeyRef,proto3,oneof"`
+}
+
+type ValueSource_SecretKeyRef struct {
+	// A key of a Secret in the target namespace containing the values.
+	SecretKeyRef *ValueSourceKeyRef `protobuf:"bytes,3,opt,name=secret_key_ref,json=secretKeyRef,proto3,oneof"`
+}
+
+type ValueSource_Preset struct {
+	// The name of a preset of values stored alongside the repository of the
+	// available package.
+	Preset string `protobuf:"bytes,4,opt,name=preset,proto3,oneof"`
+}
+
+func (*ValueSource_Inline) isValueSource_Source() {}
+
+func (*ValueSource_ConfigMapKeyRef) isValueSource_Source() {}
+
+func (*ValueSource_SecretKeyRef) isValueSource_Source() {}
+
+func (*ValueSource_Preset) isValueSource_Source() {}
+
+// ValueSourceKeyRef
 //
-// A VersionReference defines a version or constraint limiting matching versions.
-// The reason it is a separate message is so that in the future we can add other
-// fields as necessary (such as something similar to Carvel's `prereleases` option
-// to its versionSelection).
-type VersionReference struct {
+// A reference to a key of a ConfigMap or Secret.
+type ValueSourceKeyRef struct {
 	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Version
-	//
-	// The format of the version constraint depends on the backend. For example,
-	// for a flux v2 and Carvel it’s a semver expression, such as ">=10.3 < 10.4"
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the ConfigMap or Secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The key containing the values.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *VersionReference) Reset() {
-	*x = VersionReference{}
+func (x *ValueSourceKeyRef) Reset() {
+	*x = ValueSourceKeyRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VersionReference) String() string {
+func (x *ValueSourceKeyRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionReference) ProtoMessage() {}
+func (*ValueSourceKeyRef) ProtoMessage() {}
 
-func (x *VersionReference) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22]
+func (x *ValueSourceKeyRef) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2055,57 +4136,64 @@ func (x *VersionReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionReference.ProtoReflect.Descriptor instead.
-func (*VersionReference) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ValueSourceKeyRef.ProtoReflect.Descriptor instead.
+func (*ValueSourceKeyRef) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *VersionReference) GetVersion() string {
+func (x *ValueSourceKeyRef) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
 	return ""
 }
 
-// InstalledPackageStatus
+func (x *ValueSourceKeyRef) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// ValueOrigin
 //
-// An InstalledPackageStatus reports on the current status of the installation.
-type InstalledPackageStatus struct {
+// The source from which a value applied to an installed package was read.
+type ValueOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Ready
-	//
-	// An indication of whether the installation is ready or not
-	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
-	// Reason
-	//
-	// An enum indicating the reason for the current status.
-	Reason InstalledPackageStatus_StatusReason `protobuf:"varint,2,opt,name=reason,proto3,enum=kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus_StatusReason" json:"reason,omitempty"`
-	// UserReason
-	//
-	// Optional text to return for user context, which may be plugin specific.
-	UserReason string `protobuf:"bytes,3,opt,name=user_reason,json=userReason,proto3" json:"user_reason,omitempty"`
-}
-
-func (x *InstalledPackageStatus) Reset() {
-	*x = InstalledPackageStatus{}
+	// The path of the value in the values applied, as dot-separated keys such
+	// as "image.tag". For a redacted source, this is the path at which its
+	// values are set, if any, since its values are not known.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Types that are assignable to Source:
+	//	*ValueOrigin_Inline
+	//	*ValueOrigin_ConfigMapKeyRef
+	//	*ValueOrigin_SecretKeyRef
+	Source isValueOrigin_Source `protobuf_oneof:"source"`
+	// Whether the values of the source are redacted from the values applied,
+	// since the user is not permitted to read the source.
+	Redacted bool `protobuf:"varint,5,opt,name=redacted,proto3" json:"redacted,omitempty"`
+}
+
+func (x *ValueOrigin) Reset() {
+	*x = ValueOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstalledPackageStatus) String() string {
+func (x *ValueOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstalledPackageStatus) ProtoMessage() {}
+func (*ValueOrigin) ProtoMessage() {}
 
-func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23]
+func (x *ValueOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2116,75 +4204,115 @@ func (x *InstalledPackageStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstalledPackageStatus.ProtoReflect.Descriptor instead.
-func (*InstalledPackageStatus) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ValueOrigin.ProtoReflect.Descriptor instead.
+func (*ValueOrigin) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *InstalledPackageStatus) GetReady() bool {
+func (x *ValueOrigin) GetPath() string {
 	if x != nil {
-		return x.Ready
+		return x.Path
+	}
+	return ""
+}
+
+func (m *ValueOrigin) GetSource() isValueOrigin_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *ValueOrigin) GetInline() bool {
+	if x, ok := x.GetSource().(*ValueOrigin_Inline); ok {
+		return x.Inline
 	}
 	return false
 }
 
-func (x *InstalledPackageStatus) GetReason() InstalledPackageStatus_StatusReason {
-	if x != nil {
-		return x.Reason
+func (x *ValueOrigin) GetConfigMapKeyRef() *ValueSourceKeyRef {
+	if x, ok := x.GetSource().(*ValueOrigin_ConfigMapKeyRef); ok {
+		return x.ConfigMapKeyRef
 	}
-	return InstalledPackageStatus_STATUS_REASON_UNSPECIFIED
+	return nil
 }
 
-func (x *InstalledPackageStatus) GetUserReason() string {
+func (x *ValueOrigin) GetSecretKeyRef() *ValueSourceKeyRef {
+	if x, ok := x.GetSource().(*ValueOrigin_SecretKeyRef); ok {
+		return x.SecretKeyRef
+	}
+	return nil
+}
+
+func (x *ValueOrigin) GetRedacted() bool {
 	if x != nil {
-		return x.UserReason
+		return x.Redacted
 	}
-	return ""
+	return false
 }
 
-// ReconciliationOptions
+type isValueOrigin_Source interface {
+	isValueOrigin_Source()
+}
+
+type ValueOrigin_Inline struct {
+	// Set when the value was provided inline.
+	Inline bool `protobuf:"varint,2,opt,name=inline,proto3,oneof"`
+}
+
+type ValueOrigin_ConfigMapKeyRef struct {
+	// The key of the ConfigMap from which the value was read.
+	ConfigMapKeyRef *ValueSourceKeyRef `protobuf:"bytes,3,opt,name=config_map_key_ref,json=configMapKeyRef,proto3,oneof"`
+}
+
+type ValueOrigin_SecretKeyRef struct {
+	// The key of the Secret from which the value was read.
+	SecretKeyRef *ValueSourceKeyRef `protobuf:"bytes,4,opt,name=secret_key_ref,json=secretKeyRef,proto3,oneof"`
+}
+
+func (*ValueOrigin_Inline) isValueOrigin_Source() {}
+
+func (*ValueOrigin_ConfigMapKeyRef) isValueOrigin_Source() {}
+
+func (*ValueOrigin_SecretKeyRef) isValueOrigin_Source() {}
+
+// InstalledPackageRevision
 //
-// ReconciliationOptions enable specifying standard fields for backends that continuously
-// reconcile a package install as new matching versions are released. Most of the naming
-// is from the flux HelmReleaseSpec though it maps directly to equivalent fields on Carvel's
-// InstalledPackage.
-type ReconciliationOptions struct {
+// A revision of an installed package, created each time the package is
+// installed, updated or rolled back.
+type InstalledPackageRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Reconciliation Interval
-	//
-	// The interval with which the package is checked for reconciliation (in seconds)
-	Interval int32 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	// Suspend
-	//
-	// Whether reconciliation should be suspended until otherwise enabled.
-	Suspend bool `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
-	// ServiceAccountName
-	//
-	// A name for a service account in the same namespace which should be used
-	// to perform the reconciliation.
-	ServiceAccountName string `protobuf:"bytes,3,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+	// The revision number, which increases with each change.
+	Revision int32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The package and app versions of the revision.
+	Version *PackageAppVersion `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The status of the revision, such as whether it is deployed or was
+	// superseded by a later revision.
+	Status *InstalledPackageStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The time at which the revision was last deployed.
+	Updated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated,proto3" json:"updated,omitempty"`
 }
 
-func (x *ReconciliationOptions) Reset() {
-	*x = ReconciliationOptions{}
+func (x *InstalledPackageRevision) Reset() {
+	*x = InstalledPackageRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconciliationOptions) String() string {
+func (x *InstalledPackageRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconciliationOptions) ProtoMessage() {}
+func (*InstalledPackageRevision) ProtoMessage() {}
 
-func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24]
+func (x *InstalledPackageRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,67 +4323,73 @@ func (x *ReconciliationOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconciliationOptions.ProtoReflect.Descriptor instead.
-func (*ReconciliationOptions) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use InstalledPackageRevision.ProtoReflect.Descriptor instead.
+func (*InstalledPackageRevision) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ReconciliationOptions) GetInterval() int32 {
+func (x *InstalledPackageRevision) GetRevision() int32 {
 	if x != nil {
-		return x.Interval
+		return x.Revision
 	}
 	return 0
 }
 
-func (x *ReconciliationOptions) GetSuspend() bool {
+func (x *InstalledPackageRevision) GetVersion() *PackageAppVersion {
 	if x != nil {
-		return x.Suspend
+		return x.Version
 	}
-	return false
+	return nil
 }
 
-func (x *ReconciliationOptions) GetServiceAccountName() string {
+func (x *InstalledPackageRevision) GetStatus() *InstalledPackageStatus {
 	if x != nil {
-		return x.ServiceAccountName
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-// Package AppVersion
+func (x *InstalledPackageRevision) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// ResourceDiff
 //
-// PackageAppVersion conveys both the package version and the packaged app version.
-type PackageAppVersion struct {
+// The difference for a single Kubernetes resource between the deployed
+// manifest of an installed package and the manifest of a proposed update.
+type ResourceDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Package version
-	//
-	// Version of the package itself
-	PkgVersion string `protobuf:"bytes,1,opt,name=pkg_version,json=pkgVersion,proto3" json:"pkg_version,omitempty"`
-	// Application version
-	//
-	// Version of the packaged application
-	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// A reference to the resource.
+	ResourceRef *ResourceRef `protobuf:"bytes,1,opt,name=resource_ref,json=resourceRef,proto3" json:"resource_ref,omitempty"`
+	// The type of the difference.
+	DiffType ResourceDiff_DiffType `protobuf:"varint,2,opt,name=diff_type,json=diffType,proto3,enum=kubeappsapis.core.packages.v1alpha1.ResourceDiff_DiffType" json:"diff_type,omitempty"`
+	// A unified diff between the current and the proposed YAML of the resource.
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *PackageAppVersion) Reset() {
-	*x = PackageAppVersion{}
+func (x *ResourceDiff) Reset() {
+	*x = ResourceDiff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+		mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PackageAppVersion) String() string {
+func (x *ResourceDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageAppVersion) ProtoMessage() {}
+func (*ResourceDiff) ProtoMessage() {}
 
-func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25]
+func (x *ResourceDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,21 +4400,28 @@ func (x *PackageAppVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageAppVersion.ProtoReflect.Descriptor instead.
-func (*PackageAppVersion) Descriptor() ([]byte, []int) {
-	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ResourceDiff.ProtoReflect.Descriptor instead.
+func (*ResourceDiff) Descriptor() ([]byte, []int) {
+	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *PackageAppVersion) GetPkgVersion() string {
+func (x *ResourceDiff) GetResourceRef() *ResourceRef {
 	if x != nil {
-		return x.PkgVersion
+		return x.ResourceRef
 	}
-	return ""
+	return nil
 }
 
-func (x *PackageAppVersion) GetAppVersion() string {
+func (x *ResourceDiff) GetDiffType() ResourceDiff_DiffType {
 	if x != nil {
-		return x.AppVersion
+		return x.DiffType
+	}
+	return ResourceDiff_DIFF_TYPE_UNSPECIFIED
+}
+
+func (x *ResourceDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
 	}
 	return ""
 }
@@ -2297,361 +4438,645 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x30, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
-	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x30, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x03, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
 	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0b, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x01, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a,
+	0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b,
 	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
 	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
+	0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x89, 0x04, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
 	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x65, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7c, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x55, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49,
+	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x22, 0x96,
+	0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0xc9, 0x04, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x53, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b,
+	0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x0d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x22, 0xd6, 0x03, 0x0a, 0x1d, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xeb, 0x02, 0x0a,
+	0x1b, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xb9, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
-	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x01, 0x0a,
-	0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xe0, 0x03, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xb6, 0x01,
+	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xdb, 0x01, 0x0a, 0x1d, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x22, 0x9c, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x66, 0x22, 0xa0, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x99, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72,
+	0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x66, 0x22, 0xb1, 0x01, 0x0a, 0x1f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
 	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x65, 0x0a, 0x12,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7c, 0x0a, 0x1b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x19, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x9a, 0x01, 0x0a,
+	0x21, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x23, 0x47, 0x65,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x24,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x19, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x9a, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x94,
+	0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x93, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x73, 0x22, 0x78, 0x0a, 0x1c, 0x44,
+	0x69, 0x66, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
 	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
 	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0xf2, 0x03, 0x0a,
-	0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x72,
-	0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x66, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70,
-	0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x20, 0x0a, 0x1e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83,
+	0x02, 0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x24, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x96, 0x01, 0x0a, 0x20, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x8b, 0x03, 0x0a, 0x17, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0e,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf1, 0x05, 0x0a, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62,
 	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x71,
-	0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
-	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f,
-	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xec, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x19, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0x9a, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8f, 0x01,
-	0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xcc, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x19, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9a,
-	0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
-	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x52, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x94, 0x01, 0x0a, 0x1e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72,
-	0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x83, 0x06, 0x0a, 0x17, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69,
+	0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
 	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x66, 0x22, 0x8b, 0x03, 0x0a, 0x17, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x72,
-	0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63,
+	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63,
+	0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6b, 0x67, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x6b, 0x67, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a, 0x17,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
 	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0xf1, 0x05, 0x0a, 0x16, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a, 0x15, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
 	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c,
-	0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x69,
-	0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
-	0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x6c, 0x6f, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75,
+	0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75,
 	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x61,
-	0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x22, 0x83, 0x06, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
-	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
-	0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12,
-	0x28, 0x0a, 0x10, 0x70, 0x6b, 0x67, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6b, 0x67, 0x44, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xcb, 0x09, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a, 0x15, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62,
+	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12,
+	0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5f,
+	0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
 	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
 	0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x91, 0x08, 0x0a, 0x16, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x72, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x69, 0x0a, 0x15, 0x70, 0x6b, 0x67,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x13, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41,
-	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x15, 0x72, 0x65,
-	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73,
-	0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x66, 0x12, 0x6e, 0x0a, 0x17, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x71, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
 	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x6e, 0x0a, 0x17, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
+	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x57, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b,
 	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
 	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x41,
+	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x0d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x41,
 	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
 	0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
@@ -2688,151 +5113,400 @@ var file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc = []byte{
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
 	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x7a, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x50, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
-	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x2c, 0x0a, 0x10,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x16, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x60, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x6b, 0x75,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x76, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x03, 0x22, 0xc7, 0x01, 0x0a,
+	0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
 	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
-	0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9e,
-	0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
-	0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49,
-	0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22,
-	0x7f, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x30,
-	0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x55, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x86, 0x0b, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xee, 0x01, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x2c, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x60, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6b, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6b, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x74, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x92, 0x02, 0x0a,
+	0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x06,
+	0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x5e, 0x0a,
+	0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x18, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x39, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xa8, 0x02, 0x0a,
+	0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
 	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65,
-	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0xe3, 0x01, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x66, 0x12, 0x5e, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x66, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x66, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x50, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0xea, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x47, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41,
+	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
 	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e, 0x6b, 0x75,
-	0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0xee, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xba, 0x02,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x53,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x12, 0x57, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x22, 0x68, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0xba, 0x1b, 0x0a, 0x0f, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xee,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xea, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48,
+	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x12, 0x30, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xee, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
 	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62,
-	0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45,
+	0x69, 0x65, 0x73, 0x12, 0x48, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x12, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xd7, 0x01, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0xde, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42,
 	0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22,
+	0x30, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x40, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x41, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x2e, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x3a, 0x01, 0x2a, 0x12, 0xd7, 0x01,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61,
+	0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x1a, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xd4, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0xfc,
+	0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x73, 0x12, 0x4b, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x4c, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63,
 	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xd7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x72, 0x65, 0x66, 0x73, 0x12, 0x8c, 0x02,
+	0x0a, 0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x4f, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
 	0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2e, 0x22, 0x29, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x01, 0x2a,
-	0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
-	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73,
-	0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70,
-	0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x50, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70,
+	0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c,
+	0x12, 0x3a, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0xf0, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x2e,
+	0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0xe6, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x44, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x45, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x37, 0x1a, 0x32, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0xdc, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x42, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x43, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70,
+	0x70, 0x73, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x6b,
+	0x75, 0x62, 0x65, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6b, 0x75, 0x62, 0x65,
+	0x61, 0x70, 0x70, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2847,101 +5521,195 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescGZIP() []byt
 	return file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDescData
 }
 
-var file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_kubeappsapis_core_packages_v1alpha1_packages_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_kubeappsapis_core_packages_v1alpha1_packages_proto_goTypes = []interface{}{
-	(InstalledPackageStatus_StatusReason)(0),     // 0: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
-	(*GetAvailablePackageSummariesRequest)(nil),  // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
-	(*GetAvailablePackageDetailRequest)(nil),     // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
-	(*GetAvailablePackageVersionsRequest)(nil),   // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
-	(*GetInstalledPackageSummariesRequest)(nil),  // 4: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
-	(*GetInstalledPackageDetailRequest)(nil),     // 5: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
-	(*CreateInstalledPackageRequest)(nil),        // 6: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
-	(*GetAvailablePackageSummariesResponse)(nil), // 7: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
-	(*GetAvailablePackageDetailResponse)(nil),    // 8: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
-	(*GetAvailablePackageVersionsResponse)(nil),  // 9: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
-	(*GetInstalledPackageSummariesResponse)(nil), // 10: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
-	(*GetInstalledPackageDetailResponse)(nil),    // 11: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
-	(*CreateInstalledPackageResponse)(nil),       // 12: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
-	(*AvailablePackageSummary)(nil),              // 13: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
-	(*AvailablePackageDetail)(nil),               // 14: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
-	(*InstalledPackageSummary)(nil),              // 15: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
-	(*InstalledPackageDetail)(nil),               // 16: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
-	(*Context)(nil),                              // 17: kubeappsapis.core.packages.v1alpha1.Context
-	(*AvailablePackageReference)(nil),            // 18: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	(*Maintainer)(nil),                           // 19: kubeappsapis.core.packages.v1alpha1.Maintainer
-	(*FilterOptions)(nil),                        // 20: kubeappsapis.core.packages.v1alpha1.FilterOptions
-	(*PaginationOptions)(nil),                    // 21: kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	(*InstalledPackageReference)(nil),            // 22: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	(*VersionReference)(nil),                     // 23: kubeappsapis.core.packages.v1alpha1.VersionReference
-	(*InstalledPackageStatus)(nil),               // 24: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	(*ReconciliationOptions)(nil),                // 25: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	(*PackageAppVersion)(nil),                    // 26: kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	(*anypb.Any)(nil),                            // 27: google.protobuf.Any
-	(*v1alpha1.Plugin)(nil),                      // 28: kubeappsapis.core.plugins.v1alpha1.Plugin
+	(GetInstalledPackageSummariesRequest_StatusFilter)(0), // 0: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.StatusFilter
+	(SortOptions_SortField)(0),                            // 1: kubeappsapis.core.packages.v1alpha1.SortOptions.SortField
+	(InstalledPackageStatus_StatusReason)(0),              // 2: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
+	(ResourceDiff_DiffType)(0),                            // 3: kubeappsapis.core.packages.v1alpha1.ResourceDiff.DiffType
+	(*GetAvailablePackageSummariesRequest)(nil),           // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
+	(*GetAvailablePackageDetailRequest)(nil),              // 5: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
+	(*GetAvailablePackageVersionsRequest)(nil),            // 6: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
+	(*GetInstalledPackageSummariesRequest)(nil),           // 7: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
+	(*GetInstalledPackageDetailRequest)(nil),              // 8: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
+	(*CreateInstalledPackageRequest)(nil),                 // 9: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
+	(*RenderInstalledPackageRequest)(nil),                 // 10: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest
+	(*DiffInstalledPackageRequest)(nil),                   // 11: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageRequest
+	(*UpdateInstalledPackageRequest)(nil),                 // 12: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
+	(*DeleteInstalledPackageRequest)(nil),                 // 13: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+	(*WatchInstalledPackagesRequest)(nil),                 // 14: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest
+	(*GetInstalledPackageResourceRefsRequest)(nil),        // 15: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest
+	(*GetInstalledPackageResourceStatusesRequest)(nil),    // 16: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesRequest
+	(*GetInstalledPackageRevisionsRequest)(nil),           // 17: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsRequest
+	(*RollbackInstalledPackageRequest)(nil),               // 18: kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageRequest
+	(*GetAvailablePackageSummariesResponse)(nil),          // 19: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+	(*GetAvailablePackageDetailResponse)(nil),             // 20: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+	(*GetAvailablePackageVersionsResponse)(nil),           // 21: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+	(*GetInstalledPackageSummariesResponse)(nil),          // 22: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+	(*GetInstalledPackageDetailResponse)(nil),             // 23: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+	(*CreateInstalledPackageResponse)(nil),                // 24: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
+	(*RenderInstalledPackageResponse)(nil),                // 25: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageResponse
+	(*DiffInstalledPackageResponse)(nil),                  // 26: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageResponse
+	(*UpdateInstalledPackageResponse)(nil),                // 27: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
+	(*DeleteInstalledPackageResponse)(nil),                // 28: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
+	(*WatchInstalledPackagesResponse)(nil),                // 29: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse
+	(*GetInstalledPackageResourceRefsResponse)(nil),       // 30: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse
+	(*GetInstalledPackageResourceStatusesResponse)(nil),   // 31: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesResponse
+	(*GetInstalledPackageRevisionsResponse)(nil),          // 32: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsResponse
+	(*RollbackInstalledPackageResponse)(nil),              // 33: kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageResponse
+	(*AvailablePackageSummary)(nil),                       // 34: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
+	(*AvailablePackageDetail)(nil),                        // 35: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
+	(*InstalledPackageSummary)(nil),                       // 36: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
+	(*InstalledPackageDetail)(nil),                        // 37: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
+	(*Context)(nil),                                       // 38: kubeappsapis.core.packages.v1alpha1.Context
+	(*AvailablePackageReference)(nil),                     // 39: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	(*Maintainer)(nil),                                    // 40: kubeappsapis.core.packages.v1alpha1.Maintainer
+	(*FilterOptions)(nil),                                 // 41: kubeappsapis.core.packages.v1alpha1.FilterOptions
+	(*PaginationOptions)(nil),                             // 42: kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	(*SortOptions)(nil),                                   // 43: kubeappsapis.core.packages.v1alpha1.SortOptions
+	(*InstalledPackageReference)(nil),                     // 44: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	(*VersionReference)(nil),                              // 45: kubeappsapis.core.packages.v1alpha1.VersionReference
+	(*InstalledPackageStatus)(nil),                        // 46: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	(*ReconciliationOptions)(nil),                         // 47: kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	(*PackageAppVersion)(nil),                             // 48: kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	(*PluginError)(nil),                                   // 49: kubeappsapis.core.packages.v1alpha1.PluginError
+	(*ResourceRef)(nil),                                   // 50: kubeappsapis.core.packages.v1alpha1.ResourceRef
+	(*ResourceStatus)(nil),                                // 51: kubeappsapis.core.packages.v1alpha1.ResourceStatus
+	(*ValueSource)(nil),                                   // 52: kubeappsapis.core.packages.v1alpha1.ValueSource
+	(*ValueSourceKeyRef)(nil),                             // 53: kubeappsapis.core.packages.v1alpha1.ValueSourceKeyRef
+	(*ValueOrigin)(nil),                                   // 54: kubeappsapis.core.packages.v1alpha1.ValueOrigin
+	(*InstalledPackageRevision)(nil),                      // 55: kubeappsapis.core.packages.v1alpha1.InstalledPackageRevision
+	(*ResourceDiff)(nil),                                  // 56: kubeappsapis.core.packages.v1alpha1.ResourceDiff
+	(*anypb.Any)(nil),                                     // 57: google.protobuf.Any
+	(*v1alpha1.Plugin)(nil),                               // 58: kubeappsapis.core.plugins.v1alpha1.Plugin
+	(*timestamppb.Timestamp)(nil),                         // 59: google.protobuf.Timestamp
 }
 var file_kubeappsapis_core_packages_v1alpha1_packages_proto_depIdxs = []int32{
-	17, // 0: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	20, // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.filter_options:type_name -> kubeappsapis.core.packages.v1alpha1.FilterOptions
-	21, // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	18, // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	18, // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	17, // 5: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	21, // 6: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
-	22, // 7: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	18, // 8: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	17, // 9: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	23, // 10: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	25, // 11: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	13, // 12: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.available_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
-	14, // 13: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse.available_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
-	26, // 14: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse.package_app_versions:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	15, // 15: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse.installed_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
-	16, // 16: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse.installed_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
-	22, // 17: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	18, // 18: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	26, // 19: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	18, // 20: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	26, // 21: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	19, // 22: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.maintainers:type_name -> kubeappsapis.core.packages.v1alpha1.Maintainer
-	27, // 23: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.custom_detail:type_name -> google.protobuf.Any
-	22, // 24: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	23, // 25: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	26, // 26: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	26, // 27: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	26, // 28: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	24, // 29: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	22, // 30: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
-	23, // 31: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
-	26, // 32: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	25, // 33: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
-	24, // 34: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
-	18, // 35: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
-	26, // 36: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	26, // 37: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
-	27, // 38: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.custom_detail:type_name -> google.protobuf.Any
-	17, // 39: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	28, // 40: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
-	17, // 41: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
-	28, // 42: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
-	0,  // 43: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.reason:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
-	1,  // 44: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
-	2,  // 45: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
-	3,  // 46: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
-	4,  // 47: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
-	5,  // 48: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
-	6,  // 49: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
-	7,  // 50: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
-	8,  // 51: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
-	9,  // 52: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
-	10, // 53: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
-	11, // 54: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
-	12, // 55: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
-	50, // [50:56] is the sub-list for method output_type
-	44, // [44:50] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	38,  // 0: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	41,  // 1: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.filter_options:type_name -> kubeappsapis.core.packages.v1alpha1.FilterOptions
+	42,  // 2: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	43,  // 3: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest.sort_options:type_name -> kubeappsapis.core.packages.v1alpha1.SortOptions
+	39,  // 4: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	39,  // 5: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	38,  // 6: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	42,  // 7: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.pagination_options:type_name -> kubeappsapis.core.packages.v1alpha1.PaginationOptions
+	0,   // 8: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.status_filters:type_name -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest.StatusFilter
+	44,  // 9: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	39,  // 10: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	38,  // 11: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	45,  // 12: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	47,  // 13: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	52,  // 14: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest.value_sources:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSource
+	39,  // 15: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	38,  // 16: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest.target_context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	45,  // 17: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	52,  // 18: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest.value_sources:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSource
+	44,  // 19: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	45,  // 20: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	52,  // 21: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageRequest.value_sources:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSource
+	44,  // 22: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	45,  // 23: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	47,  // 24: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	52,  // 25: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest.value_sources:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSource
+	44,  // 26: kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	38,  // 27: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	44,  // 28: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	44,  // 29: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	44,  // 30: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	44,  // 31: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	44,  // 32: kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageRequest.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	34,  // 33: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.available_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary
+	49,  // 34: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse.plugin_errors:type_name -> kubeappsapis.core.packages.v1alpha1.PluginError
+	35,  // 35: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse.available_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail
+	48,  // 36: kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse.package_app_versions:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	36,  // 37: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse.installed_package_summaries:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary
+	49,  // 38: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse.plugin_errors:type_name -> kubeappsapis.core.packages.v1alpha1.PluginError
+	37,  // 39: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse.installed_package_detail:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail
+	44,  // 40: kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	50,  // 41: kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageResponse.resource_refs:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceRef
+	56,  // 42: kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageResponse.resource_diffs:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceDiff
+	44,  // 43: kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	44,  // 44: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	46,  // 45: kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	50,  // 46: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse.resource_refs:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceRef
+	51,  // 47: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesResponse.resource_statuses:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceStatus
+	55,  // 48: kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsResponse.revisions:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageRevision
+	44,  // 49: kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageResponse.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	39,  // 50: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	48,  // 51: kubeappsapis.core.packages.v1alpha1.AvailablePackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	39,  // 52: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	48,  // 53: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	40,  // 54: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.maintainers:type_name -> kubeappsapis.core.packages.v1alpha1.Maintainer
+	57,  // 55: kubeappsapis.core.packages.v1alpha1.AvailablePackageDetail.custom_detail:type_name -> google.protobuf.Any
+	44,  // 56: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	45,  // 57: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	48,  // 58: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	48,  // 59: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	48,  // 60: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	46,  // 61: kubeappsapis.core.packages.v1alpha1.InstalledPackageSummary.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	44,  // 62: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.installed_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageReference
+	45,  // 63: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.pkg_version_reference:type_name -> kubeappsapis.core.packages.v1alpha1.VersionReference
+	48,  // 64: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.current_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	47,  // 65: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.reconciliation_options:type_name -> kubeappsapis.core.packages.v1alpha1.ReconciliationOptions
+	46,  // 66: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	39,  // 67: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.available_package_ref:type_name -> kubeappsapis.core.packages.v1alpha1.AvailablePackageReference
+	48,  // 68: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_matching_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	48,  // 69: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.latest_version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	57,  // 70: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.custom_detail:type_name -> google.protobuf.Any
+	54,  // 71: kubeappsapis.core.packages.v1alpha1.InstalledPackageDetail.values_origins:type_name -> kubeappsapis.core.packages.v1alpha1.ValueOrigin
+	38,  // 72: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	58,  // 73: kubeappsapis.core.packages.v1alpha1.AvailablePackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	1,   // 74: kubeappsapis.core.packages.v1alpha1.SortOptions.field:type_name -> kubeappsapis.core.packages.v1alpha1.SortOptions.SortField
+	38,  // 75: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.context:type_name -> kubeappsapis.core.packages.v1alpha1.Context
+	58,  // 76: kubeappsapis.core.packages.v1alpha1.InstalledPackageReference.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	2,   // 77: kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.reason:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus.StatusReason
+	58,  // 78: kubeappsapis.core.packages.v1alpha1.PluginError.plugin:type_name -> kubeappsapis.core.plugins.v1alpha1.Plugin
+	50,  // 79: kubeappsapis.core.packages.v1alpha1.ResourceStatus.resource_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceRef
+	53,  // 80: kubeappsapis.core.packages.v1alpha1.ValueSource.config_map_key_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSourceKeyRef
+	53,  // 81: kubeappsapis.core.packages.v1alpha1.ValueSource.secret_key_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSourceKeyRef
+	53,  // 82: kubeappsapis.core.packages.v1alpha1.ValueOrigin.config_map_key_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSourceKeyRef
+	53,  // 83: kubeappsapis.core.packages.v1alpha1.ValueOrigin.secret_key_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ValueSourceKeyRef
+	48,  // 84: kubeappsapis.core.packages.v1alpha1.InstalledPackageRevision.version:type_name -> kubeappsapis.core.packages.v1alpha1.PackageAppVersion
+	46,  // 85: kubeappsapis.core.packages.v1alpha1.InstalledPackageRevision.status:type_name -> kubeappsapis.core.packages.v1alpha1.InstalledPackageStatus
+	59,  // 86: kubeappsapis.core.packages.v1alpha1.InstalledPackageRevision.updated:type_name -> google.protobuf.Timestamp
+	50,  // 87: kubeappsapis.core.packages.v1alpha1.ResourceDiff.resource_ref:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceRef
+	3,   // 88: kubeappsapis.core.packages.v1alpha1.ResourceDiff.diff_type:type_name -> kubeappsapis.core.packages.v1alpha1.ResourceDiff.DiffType
+	4,   // 89: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesRequest
+	5,   // 90: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailRequest
+	6,   // 91: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:input_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsRequest
+	7,   // 92: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesRequest
+	8,   // 93: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailRequest
+	9,   // 94: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageRequest
+	10,  // 95: kubeappsapis.core.packages.v1alpha1.PackagesService.RenderInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageRequest
+	11,  // 96: kubeappsapis.core.packages.v1alpha1.PackagesService.DiffInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageRequest
+	12,  // 97: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageRequest
+	13,  // 98: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageRequest
+	15,  // 99: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageResourceRefs:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsRequest
+	16,  // 100: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageResourceStatuses:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesRequest
+	17,  // 101: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageRevisions:input_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsRequest
+	18,  // 102: kubeappsapis.core.packages.v1alpha1.PackagesService.RollbackInstalledPackage:input_type -> kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageRequest
+	14,  // 103: kubeappsapis.core.packages.v1alpha1.PackagesService.WatchInstalledPackages:input_type -> kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesRequest
+	19,  // 104: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageSummariesResponse
+	20,  // 105: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageDetailResponse
+	21,  // 106: kubeappsapis.core.packages.v1alpha1.PackagesService.GetAvailablePackageVersions:output_type -> kubeappsapis.core.packages.v1alpha1.GetAvailablePackageVersionsResponse
+	22,  // 107: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageSummaries:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageSummariesResponse
+	23,  // 108: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageDetail:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageDetailResponse
+	24,  // 109: kubeappsapis.core.packages.v1alpha1.PackagesService.CreateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.CreateInstalledPackageResponse
+	25,  // 110: kubeappsapis.core.packages.v1alpha1.PackagesService.RenderInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.RenderInstalledPackageResponse
+	26,  // 111: kubeappsapis.core.packages.v1alpha1.PackagesService.DiffInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.DiffInstalledPackageResponse
+	27,  // 112: kubeappsapis.core.packages.v1alpha1.PackagesService.UpdateInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.UpdateInstalledPackageResponse
+	28,  // 113: kubeappsapis.core.packages.v1alpha1.PackagesService.DeleteInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.DeleteInstalledPackageResponse
+	30,  // 114: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageResourceRefs:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceRefsResponse
+	31,  // 115: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageResourceStatuses:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageResourceStatusesResponse
+	32,  // 116: kubeappsapis.core.packages.v1alpha1.PackagesService.GetInstalledPackageRevisions:output_type -> kubeappsapis.core.packages.v1alpha1.GetInstalledPackageRevisionsResponse
+	33,  // 117: kubeappsapis.core.packages.v1alpha1.PackagesService.RollbackInstalledPackage:output_type -> kubeappsapis.core.packages.v1alpha1.RollbackInstalledPackageResponse
+	29,  // 118: kubeappsapis.core.packages.v1alpha1.PackagesService.WatchInstalledPackages:output_type -> kubeappsapis.core.packages.v1alpha1.WatchInstalledPackagesResponse
+	104, // [104:119] is the sub-list for method output_type
+	89,  // [89:104] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() }
@@ -2962,8 +5730,92 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageDetailRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailablePackageVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageSummariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInstalledPackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2974,8 +5826,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailablePackageVersionsRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2986,8 +5838,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageSummariesRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchInstalledPackagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2998,8 +5850,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstalledPackageDetailRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageResourceRefsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3010,8 +5862,8 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstalledPackageRequest); i {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageResourceStatusesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3022,7 +5874,31 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackInstalledPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAvailablePackageSummariesResponse); i {
 			case 0:
 				return &v.state
@@ -3034,7 +5910,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAvailablePackageDetailResponse); i {
 			case 0:
 				return &v.state
@@ -3046,7 +5922,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAvailablePackageVersionsResponse); i {
 			case 0:
 				return &v.state
@@ -3058,7 +5934,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInstalledPackageSummariesResponse); i {
 			case 0:
 				return &v.state
@@ -3070,7 +5946,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInstalledPackageDetailResponse); i {
 			case 0:
 				return &v.state
@@ -3082,7 +5958,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateInstalledPackageResponse); i {
 			case 0:
 				return &v.state
@@ -3094,7 +5970,115 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderInstalledPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffInstalledPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInstalledPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstalledPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchInstalledPackagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageResourceRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageResourceStatusesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledPackageRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackInstalledPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageSummary); i {
 			case 0:
 				return &v.state
@@ -3106,7 +6090,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageDetail); i {
 			case 0:
 				return &v.state
@@ -3118,7 +6102,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageSummary); i {
 			case 0:
 				return &v.state
@@ -3130,7 +6114,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageDetail); i {
 			case 0:
 				return &v.state
@@ -3142,7 +6126,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context); i {
 			case 0:
 				return &v.state
@@ -3154,7 +6138,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvailablePackageReference); i {
 			case 0:
 				return &v.state
@@ -3166,7 +6150,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Maintainer); i {
 			case 0:
 				return &v.state
@@ -3178,7 +6162,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterOptions); i {
 			case 0:
 				return &v.state
@@ -3190,7 +6174,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaginationOptions); i {
 			case 0:
 				return &v.state
@@ -3202,7 +6186,19 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageReference); i {
 			case 0:
 				return &v.state
@@ -3214,7 +6210,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionReference); i {
 			case 0:
 				return &v.state
@@ -3226,7 +6222,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstalledPackageStatus); i {
 			case 0:
 				return &v.state
@@ -3238,7 +6234,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconciliationOptions); i {
 			case 0:
 				return &v.state
@@ -3250,7 +6246,7 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
-		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PackageAppVersion); i {
 			case 0:
 				return &v.state
@@ -3262,14 +6258,121 @@ func file_kubeappsapis_core_packages_v1alpha1_packages_proto_init() {
 				return nil
 			}
 		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueSourceKeyRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledPackageRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[48].OneofWrappers = []interface{}{
+		(*ValueSource_Inline)(nil),
+		(*ValueSource_ConfigMapKeyRef)(nil),
+		(*ValueSource_SecretKeyRef)(nil),
+		(*ValueSource_Preset)(nil),
+	}
+	file_kubeappsapis_core_packages_v1alpha1_packages_proto_msgTypes[50].OneofWrappers = []interface{}{
+		(*ValueOrigin_Inline)(nil),
+		(*ValueOrigin_ConfigMapKeyRef)(nil),
+		(*ValueOrigin_SecretKeyRef)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kubeappsapis_core_packages_v1alpha1_packages_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   26,
+			NumEnums:      4,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,18 @@ type PackagesServiceClient interface {
 	GetInstalledPackageSummaries(ctx context.Context, in *GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*GetInstalledPackageSummariesResponse, error)
 	GetInstalledPackageDetail(ctx context.Context, in *GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(ctx context.Context, in *CreateInstalledPackageRequest, opts ...grpc.CallOption) (*CreateInstalledPackageResponse, error)
+	RenderInstalledPackage(ctx context.Context, in *RenderInstalledPackageRequest, opts ...grpc.CallOption) (*RenderInstalledPackageResponse, error)
+	DiffInstalledPackage(ctx context.Context, in *DiffInstalledPackageRequest, opts ...grpc.CallOption) (*DiffInstalledPackageResponse, error)
+	UpdateInstalledPackage(ctx context.Context, in *UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*UpdateInstalledPackageResponse, error)
+	DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error)
+	GetInstalledPackageResourceRefs(ctx context.Context, in *GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceRefsResponse, error)
+	GetInstalledPackageResourceStatuses(ctx context.Context, in *GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceStatusesResponse, error)
+	GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error)
+	RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error)
+	// WatchInstalledPackages streams the status of the installed packages as
+	// it changes. It is only available on the core API, as the plugins cannot
+	// implement both the core and their own streaming service.
+	WatchInstalledPackages(ctx context.Context, in *WatchInstalledPackagesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackagesClient, error)
 }
 
 type packagesServiceClient struct {
@@ -88,6 +100,110 @@ func (c *packagesServiceClient) CreateInstalledPackage(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *packagesServiceClient) RenderInstalledPackage(ctx context.Context, in *RenderInstalledPackageRequest, opts ...grpc.CallOption) (*RenderInstalledPackageResponse, error) {
+	out := new(RenderInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/RenderInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) DiffInstalledPackage(ctx context.Context, in *DiffInstalledPackageRequest, opts ...grpc.CallOption) (*DiffInstalledPackageResponse, error) {
+	out := new(DiffInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/DiffInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*UpdateInstalledPackageResponse, error) {
+	out := new(UpdateInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*DeleteInstalledPackageResponse, error) {
+	out := new(DeleteInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, in *GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceRefsResponse, error) {
+	out := new(GetInstalledPackageResourceRefsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceRefs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageResourceStatuses(ctx context.Context, in *GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*GetInstalledPackageResourceStatusesResponse, error) {
+	out := new(GetInstalledPackageResourceStatusesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*GetInstalledPackageRevisionsResponse, error) {
+	out := new(GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*RollbackInstalledPackageResponse, error) {
+	out := new(RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.core.packages.v1alpha1.PackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packagesServiceClient) WatchInstalledPackages(ctx context.Context, in *WatchInstalledPackagesRequest, opts ...grpc.CallOption) (PackagesService_WatchInstalledPackagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PackagesService_ServiceDesc.Streams[0], "/kubeappsapis.core.packages.v1alpha1.PackagesService/WatchInstalledPackages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packagesServiceWatchInstalledPackagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PackagesService_WatchInstalledPackagesClient interface {
+	Recv() (*WatchInstalledPackagesResponse, error)
+	grpc.ClientStream
+}
+
+type packagesServiceWatchInstalledPackagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *packagesServiceWatchInstalledPackagesClient) Recv() (*WatchInstalledPackagesResponse, error) {
+	m := new(WatchInstalledPackagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PackagesServiceServer is the server API for PackagesService service.
 // All implementations should embed UnimplementedPackagesServiceServer
 // for forward compatibility
@@ -98,6 +214,18 @@ type PackagesServiceServer interface {
 	GetInstalledPackageSummaries(context.Context, *GetInstalledPackageSummariesRequest) (*GetInstalledPackageSummariesResponse, error)
 	GetInstalledPackageDetail(context.Context, *GetInstalledPackageDetailRequest) (*GetInstalledPackageDetailResponse, error)
 	CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error)
+	RenderInstalledPackage(context.Context, *RenderInstalledPackageRequest) (*RenderInstalledPackageResponse, error)
+	DiffInstalledPackage(context.Context, *DiffInstalledPackageRequest) (*DiffInstalledPackageResponse, error)
+	UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error)
+	DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error)
+	GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error)
+	GetInstalledPackageResourceStatuses(context.Context, *GetInstalledPackageResourceStatusesRequest) (*GetInstalledPackageResourceStatusesResponse, error)
+	GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error)
+	RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error)
+	// WatchInstalledPackages streams the status of the installed packages as
+	// it changes. It is only available on the core API, as the plugins cannot
+	// implement both the core and their own streaming service.
+	WatchInstalledPackages(*WatchInstalledPackagesRequest, PackagesService_WatchInstalledPackagesServer) error
 }
 
 // UnimplementedPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -122,6 +250,33 @@ func (UnimplementedPackagesServiceServer) GetInstalledPackageDetail(context.Cont
 func (UnimplementedPackagesServiceServer) CreateInstalledPackage(context.Context, *CreateInstalledPackageRequest) (*CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
+func (UnimplementedPackagesServiceServer) RenderInstalledPackage(context.Context, *RenderInstalledPackageRequest) (*RenderInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) DiffInstalledPackage(context.Context, *DiffInstalledPackageRequest) (*DiffInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) UpdateInstalledPackage(context.Context, *UpdateInstalledPackageRequest) (*UpdateInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) DeleteInstalledPackage(context.Context, *DeleteInstalledPackageRequest) (*DeleteInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *GetInstalledPackageResourceRefsRequest) (*GetInstalledPackageResourceRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageResourceStatuses(context.Context, *GetInstalledPackageResourceStatusesRequest) (*GetInstalledPackageResourceStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceStatuses not implemented")
+}
+func (UnimplementedPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *GetInstalledPackageRevisionsRequest) (*GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedPackagesServiceServer) RollbackInstalledPackage(context.Context, *RollbackInstalledPackageRequest) (*RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedPackagesServiceServer) WatchInstalledPackages(*WatchInstalledPackagesRequest, PackagesService_WatchInstalledPackagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstalledPackages not implemented")
+}
 
 // UnsafePackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PackagesServiceServer will
@@ -242,6 +397,171 @@ func _PackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackagesService_RenderInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).RenderInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/RenderInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).RenderInstalledPackage(ctx, req.(*RenderInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_DiffInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).DiffInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/DiffInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).DiffInstalledPackage(ctx, req.(*DiffInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).UpdateInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/UpdateInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).UpdateInstalledPackage(ctx, req.(*UpdateInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).DeleteInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/DeleteInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).DeleteInstalledPackage(ctx, req.(*DeleteInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageResourceRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageResourceRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceRefs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageResourceRefs(ctx, req.(*GetInstalledPackageResourceRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageResourceStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageResourceStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageResourceStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, req.(*GetInstalledPackageResourceStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.core.packages.v1alpha1.PackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackagesServiceServer).RollbackInstalledPackage(ctx, req.(*RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackagesService_WatchInstalledPackages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstalledPackagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PackagesServiceServer).WatchInstalledPackages(m, &packagesServiceWatchInstalledPackagesServer{stream})
+}
+
+type PackagesService_WatchInstalledPackagesServer interface {
+	Send(*WatchInstalledPackagesResponse) error
+	grpc.ServerStream
+}
+
+type packagesServiceWatchInstalledPackagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *packagesServiceWatchInstalledPackagesServer) Send(m *WatchInstalledPackagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PackagesService_ServiceDesc is the grpc.ServiceDesc for PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -273,7 +593,45 @@ var PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _PackagesService_CreateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "RenderInstalledPackage",
+			Handler:    _PackagesService_RenderInstalledPackage_Handler,
+		},
+		{
+			MethodName: "DiffInstalledPackage",
+			Handler:    _PackagesService_DiffInstalledPackage_Handler,
+		},
+		{
+			MethodName: "UpdateInstalledPackage",
+			Handler:    _PackagesService_UpdateInstalledPackage_Handler,
+		},
+		{
+			MethodName: "DeleteInstalledPackage",
+			Handler:    _PackagesService_DeleteInstalledPackage_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceRefs",
+			Handler:    _PackagesService_GetInstalledPackageResourceRefs_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceStatuses",
+			Handler:    _PackagesService_GetInstalledPackageResourceStatuses_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _PackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _PackagesService_RollbackInstalledPackage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstalledPackages",
+			Handler:       _PackagesService_WatchInstalledPackages_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/core/packages/v1alpha1/packages.proto",
 }
@@ -27,12 +27,26 @@ type FluxV2PackagesServiceClient interface {
 	GetAvailablePackageVersions(ctx context.Context, in *v1alpha1.GetAvailablePackageVersionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
 	// GetPackageRepositories returns the repositories managed by the 'fluxv2' plugin
 	GetPackageRepositories(ctx context.Context, in *GetPackageRepositoriesRequest, opts ...grpc.CallOption) (*GetPackageRepositoriesResponse, error)
+	// CreatePackageRepository creates a flux HelmRepository based on the request
+	CreatePackageRepository(ctx context.Context, in *CreatePackageRepositoryRequest, opts ...grpc.CallOption) (*CreatePackageRepositoryResponse, error)
+	// UpdatePackageRepository updates a flux HelmRepository based on the request
+	UpdatePackageRepository(ctx context.Context, in *UpdatePackageRepositoryRequest, opts ...grpc.CallOption) (*UpdatePackageRepositoryResponse, error)
+	// DeletePackageRepository deletes a flux HelmRepository based on the request
+	DeletePackageRepository(ctx context.Context, in *DeletePackageRepositoryRequest, opts ...grpc.CallOption) (*DeletePackageRepositoryResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'fluxv2' plugin
 	GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'fluxv2' plugin
 	GetInstalledPackageDetail(ctx context.Context, in *v1alpha1.GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDetailResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
+	// UpdateInstalledPackage updates an installed package based on the request.
+	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// DeleteInstalledPackage deletes an installed package based on the request.
+	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references to the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceStatuses(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error)
 }
 
 type fluxV2PackagesServiceClient struct {
@@ -79,6 +93,33 @@ func (c *fluxV2PackagesServiceClient) GetPackageRepositories(ctx context.Context
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) CreatePackageRepository(ctx context.Context, in *CreatePackageRepositoryRequest, opts ...grpc.CallOption) (*CreatePackageRepositoryResponse, error) {
+	out := new(CreatePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CreatePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) UpdatePackageRepository(ctx context.Context, in *UpdatePackageRepositoryRequest, opts ...grpc.CallOption) (*UpdatePackageRepositoryResponse, error) {
+	out := new(UpdatePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/UpdatePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) DeletePackageRepository(ctx context.Context, in *DeletePackageRepositoryRequest, opts ...grpc.CallOption) (*DeletePackageRepositoryResponse, error) {
+	out := new(DeletePackageRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeletePackageRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fluxV2PackagesServiceClient) GetInstalledPackageSummaries(ctx context.Context, in *v1alpha1.GetInstalledPackageSummariesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	out := new(v1alpha1.GetInstalledPackageSummariesResponse)
 	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageSummaries", in, out, opts...)
@@ -106,6 +147,42 @@ func (c *fluxV2PackagesServiceClient) CreateInstalledPackage(ctx context.Context
 	return out, nil
 }
 
+func (c *fluxV2PackagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error) {
+	out := new(v1alpha1.UpdateInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/UpdateInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
+	out := new(v1alpha1.DeleteInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeleteInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageResourceRefsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceRefs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fluxV2PackagesServiceClient) GetInstalledPackageResourceStatuses(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageResourceStatusesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FluxV2PackagesServiceServer is the server API for FluxV2PackagesService service.
 // All implementations should embed UnimplementedFluxV2PackagesServiceServer
 // for forward compatibility
@@ -118,12 +195,26 @@ type FluxV2PackagesServiceServer interface {
 	GetAvailablePackageVersions(context.Context, *v1alpha1.GetAvailablePackageVersionsRequest) (*v1alpha1.GetAvailablePackageVersionsResponse, error)
 	// GetPackageRepositories returns the repositories managed by the 'fluxv2' plugin
 	GetPackageRepositories(context.Context, *GetPackageRepositoriesRequest) (*GetPackageRepositoriesResponse, error)
+	// CreatePackageRepository creates a flux HelmRepository based on the request
+	CreatePackageRepository(context.Context, *CreatePackageRepositoryRequest) (*CreatePackageRepositoryResponse, error)
+	// UpdatePackageRepository updates a flux HelmRepository based on the request
+	UpdatePackageRepository(context.Context, *UpdatePackageRepositoryRequest) (*UpdatePackageRepositoryResponse, error)
+	// DeletePackageRepository deletes a flux HelmRepository based on the request
+	DeletePackageRepository(context.Context, *DeletePackageRepositoryRequest) (*DeletePackageRepositoryResponse, error)
 	// GetInstalledPackageSummaries returns the installed packages managed by the 'fluxv2' plugin
 	GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error)
 	// GetInstalledPackageDetail returns the requested installed package managed by the 'fluxv2' plugin
 	GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
+	// UpdateInstalledPackage updates an installed package based on the request.
+	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// DeleteInstalledPackage deletes an installed package based on the request.
+	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references to the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceStatuses(context.Context, *v1alpha1.GetInstalledPackageResourceStatusesRequest) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error)
 }
 
 // UnimplementedFluxV2PackagesServiceServer should be embedded to have forward compatible implementations.
@@ -142,6 +233,15 @@ func (UnimplementedFluxV2PackagesServiceServer) GetAvailablePackageVersions(cont
 func (UnimplementedFluxV2PackagesServiceServer) GetPackageRepositories(context.Context, *GetPackageRepositoriesRequest) (*GetPackageRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackageRepositories not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) CreatePackageRepository(context.Context, *CreatePackageRepositoryRequest) (*CreatePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageRepository not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) UpdatePackageRepository(context.Context, *UpdatePackageRepositoryRequest) (*UpdatePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePackageRepository not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) DeletePackageRepository(context.Context, *DeletePackageRepositoryRequest) (*DeletePackageRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePackageRepository not implemented")
+}
 func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageSummaries(context.Context, *v1alpha1.GetInstalledPackageSummariesRequest) (*v1alpha1.GetInstalledPackageSummariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageSummaries not implemented")
 }
@@ -151,6 +251,18 @@ func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageDetail(contex
 func (UnimplementedFluxV2PackagesServiceServer) CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
+func (UnimplementedFluxV2PackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
+}
+func (UnimplementedFluxV2PackagesServiceServer) GetInstalledPackageResourceStatuses(context.Context, *v1alpha1.GetInstalledPackageResourceStatusesRequest) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceStatuses not implemented")
+}
 
 // UnsafeFluxV2PackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FluxV2PackagesServiceServer will
@@ -235,6 +347,60 @@ func _FluxV2PackagesService_GetPackageRepositories_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_CreatePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).CreatePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/CreatePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).CreatePackageRepository(ctx, req.(*CreatePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_UpdatePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).UpdatePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/UpdatePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).UpdatePackageRepository(ctx, req.(*UpdatePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_DeletePackageRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePackageRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).DeletePackageRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeletePackageRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).DeletePackageRepository(ctx, req.(*DeletePackageRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FluxV2PackagesService_GetInstalledPackageSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1alpha1.GetInstalledPackageSummariesRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +455,78 @@ func _FluxV2PackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FluxV2PackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.UpdateInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).UpdateInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/UpdateInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).UpdateInstalledPackage(ctx, req.(*v1alpha1.UpdateInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.DeleteInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).DeleteInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/DeleteInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).DeleteInstalledPackage(ctx, req.(*v1alpha1.DeleteInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageResourceRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageResourceRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceRefs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageResourceRefs(ctx, req.(*v1alpha1.GetInstalledPackageResourceRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FluxV2PackagesService_GetInstalledPackageResourceStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageResourceStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.fluxv2.packages.v1alpha1.FluxV2PackagesService/GetInstalledPackageResourceStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FluxV2PackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, req.(*v1alpha1.GetInstalledPackageResourceStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FluxV2PackagesService_ServiceDesc is the grpc.ServiceDesc for FluxV2PackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -312,6 +550,18 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackageRepositories",
 			Handler:    _FluxV2PackagesService_GetPackageRepositories_Handler,
 		},
+		{
+			MethodName: "CreatePackageRepository",
+			Handler:    _FluxV2PackagesService_CreatePackageRepository_Handler,
+		},
+		{
+			MethodName: "UpdatePackageRepository",
+			Handler:    _FluxV2PackagesService_UpdatePackageRepository_Handler,
+		},
+		{
+			MethodName: "DeletePackageRepository",
+			Handler:    _FluxV2PackagesService_DeletePackageRepository_Handler,
+		},
 		{
 			MethodName: "GetInstalledPackageSummaries",
 			Handler:    _FluxV2PackagesService_GetInstalledPackageSummaries_Handler,
@@ -324,6 +574,22 @@ var FluxV2PackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _FluxV2PackagesService_CreateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "UpdateInstalledPackage",
+			Handler:    _FluxV2PackagesService_UpdateInstalledPackage_Handler,
+		},
+		{
+			MethodName: "DeleteInstalledPackage",
+			Handler:    _FluxV2PackagesService_DeleteInstalledPackage_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceRefs",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageResourceRefs_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceStatuses",
+			Handler:    _FluxV2PackagesService_GetInstalledPackageResourceStatuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/fluxv2/packages/v1alpha1/fluxv2.proto",
@@ -31,6 +31,24 @@ type HelmPackagesServiceClient interface {
 	GetInstalledPackageDetail(ctx context.Context, in *v1alpha1.GetInstalledPackageDetailRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageDetailResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(ctx context.Context, in *v1alpha1.CreateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.CreateInstalledPackageResponse, error)
+	// UpdateInstalledPackage updates an installed package based on the request.
+	UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// DeleteInstalledPackage deletes an installed package based on the request.
+	DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references to the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceStatuses(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error)
+	// GetInstalledPackageRevisions returns the revision history of an installed package.
+	GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// RenderInstalledPackage renders the manifest of a package as it would be installed, without installing it.
+	RenderInstalledPackage(ctx context.Context, in *v1alpha1.RenderInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderInstalledPackageResponse, error)
+	// DiffInstalledPackage returns the differences between the resources of an installed package and those of a proposed update.
+	DiffInstalledPackage(ctx context.Context, in *v1alpha1.DiffInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DiffInstalledPackageResponse, error)
+	// RunInstalledPackageTests runs the test hooks of an installed package and returns their results.
+	RunInstalledPackageTests(ctx context.Context, in *RunInstalledPackageTestsRequest, opts ...grpc.CallOption) (*RunInstalledPackageTestsResponse, error)
 }
 
 type helmPackagesServiceClient struct {
@@ -95,6 +113,87 @@ func (c *helmPackagesServiceClient) CreateInstalledPackage(ctx context.Context,
 	return out, nil
 }
 
+func (c *helmPackagesServiceClient) UpdateInstalledPackage(ctx context.Context, in *v1alpha1.UpdateInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.UpdateInstalledPackageResponse, error) {
+	out := new(v1alpha1.UpdateInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/UpdateInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) DeleteInstalledPackage(ctx context.Context, in *v1alpha1.DeleteInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteInstalledPackageResponse, error) {
+	out := new(v1alpha1.DeleteInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageResourceRefs(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceRefsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageResourceRefsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageResourceStatuses(ctx context.Context, in *v1alpha1.GetInstalledPackageResourceStatusesRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageResourceStatusesResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) GetInstalledPackageRevisions(ctx context.Context, in *v1alpha1.GetInstalledPackageRevisionsRequest, opts ...grpc.CallOption) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	out := new(v1alpha1.GetInstalledPackageRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) RollbackInstalledPackage(ctx context.Context, in *v1alpha1.RollbackInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	out := new(v1alpha1.RollbackInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) RenderInstalledPackage(ctx context.Context, in *v1alpha1.RenderInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.RenderInstalledPackageResponse, error) {
+	out := new(v1alpha1.RenderInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RenderInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) DiffInstalledPackage(ctx context.Context, in *v1alpha1.DiffInstalledPackageRequest, opts ...grpc.CallOption) (*v1alpha1.DiffInstalledPackageResponse, error) {
+	out := new(v1alpha1.DiffInstalledPackageResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DiffInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *helmPackagesServiceClient) RunInstalledPackageTests(ctx context.Context, in *RunInstalledPackageTestsRequest, opts ...grpc.CallOption) (*RunInstalledPackageTestsResponse, error) {
+	out := new(RunInstalledPackageTestsResponse)
+	err := c.cc.Invoke(ctx, "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RunInstalledPackageTests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HelmPackagesServiceServer is the server API for HelmPackagesService service.
 // All implementations should embed UnimplementedHelmPackagesServiceServer
 // for forward compatibility
@@ -111,6 +210,24 @@ type HelmPackagesServiceServer interface {
 	GetInstalledPackageDetail(context.Context, *v1alpha1.GetInstalledPackageDetailRequest) (*v1alpha1.GetInstalledPackageDetailResponse, error)
 	// CreateInstalledPackage creates an installed package based on the request.
 	CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error)
+	// UpdateInstalledPackage updates an installed package based on the request.
+	UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error)
+	// DeleteInstalledPackage deletes an installed package based on the request.
+	DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error)
+	// GetInstalledPackageResourceRefs returns the references to the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error)
+	// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes resources created by an installed package.
+	GetInstalledPackageResourceStatuses(context.Context, *v1alpha1.GetInstalledPackageResourceStatusesRequest) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error)
+	// GetInstalledPackageRevisions returns the revision history of an installed package.
+	GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error)
+	// RollbackInstalledPackage rolls back an installed package to a previous revision.
+	RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error)
+	// RenderInstalledPackage renders the manifest of a package as it would be installed, without installing it.
+	RenderInstalledPackage(context.Context, *v1alpha1.RenderInstalledPackageRequest) (*v1alpha1.RenderInstalledPackageResponse, error)
+	// DiffInstalledPackage returns the differences between the resources of an installed package and those of a proposed update.
+	DiffInstalledPackage(context.Context, *v1alpha1.DiffInstalledPackageRequest) (*v1alpha1.DiffInstalledPackageResponse, error)
+	// RunInstalledPackageTests runs the test hooks of an installed package and returns their results.
+	RunInstalledPackageTests(context.Context, *RunInstalledPackageTestsRequest) (*RunInstalledPackageTestsResponse, error)
 }
 
 // UnimplementedHelmPackagesServiceServer should be embedded to have forward compatible implementations.
@@ -135,6 +252,33 @@ func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageDetail(context.
 func (UnimplementedHelmPackagesServiceServer) CreateInstalledPackage(context.Context, *v1alpha1.CreateInstalledPackageRequest) (*v1alpha1.CreateInstalledPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstalledPackage not implemented")
 }
+func (UnimplementedHelmPackagesServiceServer) UpdateInstalledPackage(context.Context, *v1alpha1.UpdateInstalledPackageRequest) (*v1alpha1.UpdateInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) DeleteInstalledPackage(context.Context, *v1alpha1.DeleteInstalledPackageRequest) (*v1alpha1.DeleteInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageResourceRefs(context.Context, *v1alpha1.GetInstalledPackageResourceRefsRequest) (*v1alpha1.GetInstalledPackageResourceRefsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceRefs not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageResourceStatuses(context.Context, *v1alpha1.GetInstalledPackageResourceStatusesRequest) (*v1alpha1.GetInstalledPackageResourceStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageResourceStatuses not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) GetInstalledPackageRevisions(context.Context, *v1alpha1.GetInstalledPackageRevisionsRequest) (*v1alpha1.GetInstalledPackageRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackageRevisions not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) RollbackInstalledPackage(context.Context, *v1alpha1.RollbackInstalledPackageRequest) (*v1alpha1.RollbackInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) RenderInstalledPackage(context.Context, *v1alpha1.RenderInstalledPackageRequest) (*v1alpha1.RenderInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) DiffInstalledPackage(context.Context, *v1alpha1.DiffInstalledPackageRequest) (*v1alpha1.DiffInstalledPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffInstalledPackage not implemented")
+}
+func (UnimplementedHelmPackagesServiceServer) RunInstalledPackageTests(context.Context, *RunInstalledPackageTestsRequest) (*RunInstalledPackageTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunInstalledPackageTests not implemented")
+}
 
 // UnsafeHelmPackagesServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HelmPackagesServiceServer will
@@ -255,6 +399,168 @@ func _HelmPackagesService_CreateInstalledPackage_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HelmPackagesService_UpdateInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.UpdateInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).UpdateInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/UpdateInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).UpdateInstalledPackage(ctx, req.(*v1alpha1.UpdateInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_DeleteInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.DeleteInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).DeleteInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DeleteInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).DeleteInstalledPackage(ctx, req.(*v1alpha1.DeleteInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageResourceRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageResourceRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageResourceRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceRefs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageResourceRefs(ctx, req.(*v1alpha1.GetInstalledPackageResourceRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageResourceStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageResourceStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageResourceStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageResourceStatuses(ctx, req.(*v1alpha1.GetInstalledPackageResourceStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_GetInstalledPackageRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetInstalledPackageRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/GetInstalledPackageRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).GetInstalledPackageRevisions(ctx, req.(*v1alpha1.GetInstalledPackageRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_RollbackInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RollbackInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).RollbackInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RollbackInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).RollbackInstalledPackage(ctx, req.(*v1alpha1.RollbackInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_RenderInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.RenderInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).RenderInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RenderInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).RenderInstalledPackage(ctx, req.(*v1alpha1.RenderInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_DiffInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.DiffInstalledPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).DiffInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/DiffInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).DiffInstalledPackage(ctx, req.(*v1alpha1.DiffInstalledPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HelmPackagesService_RunInstalledPackageTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInstalledPackageTestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelmPackagesServiceServer).RunInstalledPackageTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeappsapis.plugins.helm.packages.v1alpha1.HelmPackagesService/RunInstalledPackageTests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelmPackagesServiceServer).RunInstalledPackageTests(ctx, req.(*RunInstalledPackageTestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HelmPackagesService_ServiceDesc is the grpc.ServiceDesc for HelmPackagesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -286,6 +592,42 @@ var HelmPackagesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInstalledPackage",
 			Handler:    _HelmPackagesService_CreateInstalledPackage_Handler,
 		},
+		{
+			MethodName: "UpdateInstalledPackage",
+			Handler:    _HelmPackagesService_UpdateInstalledPackage_Handler,
+		},
+		{
+			MethodName: "DeleteInstalledPackage",
+			Handler:    _HelmPackagesService_DeleteInstalledPackage_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceRefs",
+			Handler:    _HelmPackagesService_GetInstalledPackageResourceRefs_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageResourceStatuses",
+			Handler:    _HelmPackagesService_GetInstalledPackageResourceStatuses_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackageRevisions",
+			Handler:    _HelmPackagesService_GetInstalledPackageRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackInstalledPackage",
+			Handler:    _HelmPackagesService_RollbackInstalledPackage_Handler,
+		},
+		{
+			MethodName: "RenderInstalledPackage",
+			Handler:    _HelmPackagesService_RenderInstalledPackage_Handler,
+		},
+		{
+			MethodName: "DiffInstalledPackage",
+			Handler:    _HelmPackagesService_DiffInstalledPackage_Handler,
+		},
+		{
+			MethodName: "RunInstalledPackageTests",
+			Handler:    _HelmPackagesService_RunInstalledPackageTests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kubeappsapis/plugins/helm/packages/v1alpha1/helm.proto",
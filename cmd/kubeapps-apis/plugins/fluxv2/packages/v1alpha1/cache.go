@@ -56,7 +56,11 @@ type cacheValueDeleter func(string, map[string]interface{}) (bool, error)
 // TODO (gfichtenholt) rename this to just Config when caching is separated out into core server
 // and/or caching-rleated code is moved into a separate package?
 type cacheConfig struct {
-	gvr          schema.GroupVersionResource
+	gvr schema.GroupVersionResource
+	// the cluster on which the resources are watched. Each cluster has its own
+	// cache, all of which share the same redis database, so the cluster is part
+	// of every key
+	cluster      string
 	clientGetter clientGetter
 	// 'onAdd' and 'onModify' hooks are called when a new or modified object comes about and
 	// allows the plug-in to return information about WHETHER OR NOT and WHAT is to be stored
@@ -74,7 +78,7 @@ type cacheConfig struct {
 }
 
 func newCache(config cacheConfig) (*NamespacedResourceWatcherCache, error) {
-	log.Infof("+newCache(%v)", config.gvr)
+	log.Infof("+newCache(%v, %s)", config.gvr, config.cluster)
 	// TODO (gfichtenholt) small preference for reading all config in the main.go
 	// (whether from env vars or cmd-line options) only in the one spot and passing
 	// explicitly to functions (so functions are less dependent on env state).
@@ -168,7 +172,7 @@ func (c NamespacedResourceWatcherCache) isGvrValid() error {
 	}
 	// sanity check that CRD for GVR has been registered
 	ctx := context.Background()
-	_, apiExt, err := c.config.clientGetter(ctx)
+	_, apiExt, err := c.config.clientGetter(ctx, c.config.cluster)
 	if err != nil {
 		return status.Errorf(codes.FailedPrecondition, "clientGetter failed due to: %v", err)
 	} else if apiExt == nil {
@@ -224,7 +228,7 @@ func (c NamespacedResourceWatcherCache) watchLoop(watcher *watchutil.RetryWatche
 func (c NamespacedResourceWatcherCache) Watch(options metav1.ListOptions) (watch.Interface, error) {
 	ctx := context.Background()
 
-	dynamicClient, _, err := c.config.clientGetter(ctx)
+	dynamicClient, _, err := c.config.clientGetter(ctx, c.config.cluster)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "unable to get client due to: %v", err)
 	}
@@ -241,7 +245,7 @@ func (c NamespacedResourceWatcherCache) Watch(options metav1.ListOptions) (watch
 func (c NamespacedResourceWatcherCache) resync() (string, error) {
 	ctx := context.Background()
 
-	dynamicClient, _, err := c.config.clientGetter(ctx)
+	dynamicClient, _, err := c.config.clientGetter(ctx, c.config.cluster)
 	if err != nil {
 		return "", status.Errorf(codes.FailedPrecondition, "unable to get client due to: %v", err)
 	}
@@ -268,9 +272,9 @@ func (c NamespacedResourceWatcherCache) resync() (string, error) {
 		return "", status.Errorf(codes.Internal, "List() call response does not contain resource version")
 	}
 
-	// clear the entire cache in one call
-	c.redisCli.FlushDB(c.redisCli.Context()).Err()
-	if err != nil {
+	// clear the keys of this cluster only, since the caches of the other
+	// clusters share the same redis database
+	if err = c.clear(); err != nil {
 		return "", err
 	}
 
@@ -437,12 +441,13 @@ func (c NamespacedResourceWatcherCache) listKeys(filters []string) ([]string, er
 	// 3) simulate a HashSet in go to make sure we have no duplicates, as SCAN may
 	// return duplicates
 	redisKeys := map[string]struct{}{}
-	match := []string{""} // everything by default
+	// everything on this cluster by default
+	match := []string{fmt.Sprintf("%s:%s:*", c.config.gvr.Resource, c.config.cluster)}
 
 	if len(filters) > 0 {
 		match = make([]string, len(filters))
 		for i, f := range filters {
-			match[i] = fmt.Sprintf("%s:*:%s", c.config.gvr.Resource, f)
+			match[i] = fmt.Sprintf("%s:%s:*:%s", c.config.gvr.Resource, c.config.cluster, f)
 		}
 	}
 
@@ -475,6 +480,18 @@ func (c NamespacedResourceWatcherCache) listKeys(filters []string) ([]string, er
 	return resultKeys, nil
 }
 
+// clear removes all the keys of this cache from redis
+func (c NamespacedResourceWatcherCache) clear() error {
+	keys, err := c.listKeys(nil)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.redisCli.Del(c.redisCli.Context(), keys...).Err()
+}
+
 func (c NamespacedResourceWatcherCache) fetchForMultiple(keys []string) (map[string]interface{}, error) {
 	response := make(map[string]interface{})
 	for _, key := range keys {
@@ -501,21 +518,21 @@ func (c NamespacedResourceWatcherCache) keyForNamespacedName(name types.Namespac
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmrepository:ns:repoName"
-	return fmt.Sprintf("%s:%s:%s", c.config.gvr.Resource, name.Namespace, name.Name)
+	// We will use "helmrepositories:cluster:ns:repoName"
+	return fmt.Sprintf("%s:%s:%s:%s", c.config.gvr.Resource, c.config.cluster, name.Namespace, name.Name)
 }
 
 // the opposite of keyFor
 // the goal is to keep the details of what exactly the key looks like localized to one piece of code
 func (c NamespacedResourceWatcherCache) fromKey(key string) (*types.NamespacedName, error) {
 	parts := strings.Split(key, ":")
-	if len(parts) != 3 {
+	if len(parts) != 4 {
 		return nil, status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	if parts[0] != c.config.gvr.Resource {
+	if parts[0] != c.config.gvr.Resource || parts[1] != c.config.cluster {
 		return nil, status.Errorf(codes.Internal, "invalid key [%s]", key)
 	}
-	return &types.NamespacedName{Namespace: parts[1], Name: parts[2]}, nil
+	return &types.NamespacedName{Namespace: parts[2], Name: parts[3]}, nil
 }
 
 // computing a value for a key maybe expensive, e.g. indexing a repo takes a while,
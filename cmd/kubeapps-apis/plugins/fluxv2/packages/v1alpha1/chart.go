@@ -21,6 +21,7 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/ghodss/yaml"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/server"
 	"github.com/kubeapps/kubeapps/pkg/chart/models"
 	httpclient "github.com/kubeapps/kubeapps/pkg/http-client"
 	tar "github.com/kubeapps/kubeapps/pkg/tarutil"
@@ -49,8 +50,8 @@ const (
 	PatchVersionsInSummary = 3
 )
 
-func (s *Server) listChartsInCluster(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
-	resourceIfc, err := s.getChartsResourceInterface(ctx, namespace)
+func (s *Server) listChartsInCluster(ctx context.Context, cluster string, namespace string) (*unstructured.UnstructuredList, error) {
+	resourceIfc, err := s.getChartsResourceInterface(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +76,9 @@ func (s *Server) listChartsInCluster(ctx context.Context, namespace string) (*un
 // returns the url from which chart .tgz can be downloaded
 // here chartVersion string, if specified at all, should be specific, like "14.4.0",
 // not an expression like ">14 <15"
-func (s *Server) getChartTarball(ctx context.Context, repoName string, chartName string, namespace string, chartVersion string) (url string, err error, cleanUp func()) {
+// sourceKind is the kind of flux source the chart comes from. For GitRepositories and
+// Buckets, chartName is the path of the chart within the artifact of the source
+func (s *Server) getChartTarball(ctx context.Context, cluster string, sourceKind string, repoName string, chartName string, namespace string, chartVersion string) (url string, err error, cleanUp func()) {
 	// see if we the chart already exists
 	// TODO (gfichtenholt):
 	// see https://github.com/kubeapps/kubeapps/pull/2915
@@ -86,12 +89,12 @@ func (s *Server) getChartTarball(ctx context.Context, repoName string, chartName
 	//  - kubernetes/client-go#713 and
 	//  - https://github.com/flant/shell-operator/blob/8fa3c3b8cfeb1ddb37b070b7a871561fdffe788b///HOOKS.md#fieldselector and
 	//  - https://github.com/kubernetes/kubernetes/issues/53459
-	chartList, err := s.listChartsInCluster(ctx, namespace)
+	chartList, err := s.listChartsInCluster(ctx, cluster, namespace)
 	if err != nil {
 		return "", err, nil
 	}
 
-	url, err = findUrlForChartInList(chartList, repoName, chartName, chartVersion)
+	url, err = findUrlForChartInList(chartList, sourceKind, repoName, chartName, chartVersion)
 	if err != nil {
 		return "", err, nil
 	} else if url != "" {
@@ -107,9 +110,9 @@ func (s *Server) getChartTarball(ctx context.Context, repoName string, chartName
 	// this model toward this proposal
 	// https://github.com/fluxcd/flux2/blob/1c5a25313561771d585c4192d7f330b45753cd99/docs/proposals/secure-impersonation.md
 	// So we may not necessarily want to follow what flux does today
-	unstructuredChart := newFluxHelmChart(chartName, repoName, chartVersion)
+	unstructuredChart := newFluxHelmChart(sourceKind, chartName, repoName, chartVersion)
 
-	resourceIfc, err := s.getChartsResourceInterface(ctx, namespace)
+	resourceIfc, err := s.getChartsResourceInterface(ctx, cluster, namespace)
 	if err != nil {
 		return "", err, nil
 	}
@@ -151,8 +154,8 @@ func (s *Server) getChartTarball(ctx context.Context, repoName string, chartName
 	return url, err, cleanUp
 }
 
-func (s *Server) getChartsResourceInterface(ctx context.Context, namespace string) (dynamic.ResourceInterface, error) {
-	client, err := s.getDynamicClient(ctx)
+func (s *Server) getChartsResourceInterface(ctx context.Context, cluster string, namespace string) (dynamic.ResourceInterface, error) {
+	client, err := s.getDynamicClient(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -166,12 +169,20 @@ func (s *Server) getChartsResourceInterface(ctx context.Context, namespace strin
 	return client.Resource(chartsResource).Namespace(namespace), nil
 }
 
-func (s *Server) fetchChartFromCache(repo types.NamespacedName, chartName string) (*models.Chart, error) {
-	if s.cache == nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "server cache has not been properly initialized")
+// fetchChartFromCache looks up a chart from the named source of the given kind,
+// by its name for a HelmRepository or its path within the artifact of a
+// GitRepository or Bucket.
+func (s *Server) fetchChartFromCache(cluster, sourceKind string, repo types.NamespacedName, chartSpec string) (*models.Chart, error) {
+	if source, ok := artifactSourceForKind(sourceKind); ok {
+		return s.fetchArtifactChartFromCache(cluster, source, repo, chartSpec)
 	}
 
-	charts, err := s.cache.fetchForOne(s.cache.keyForNamespacedName(repo))
+	cache, err := s.getCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	charts, err := cache.fetchForOne(cache.keyForNamespacedName(repo))
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +194,7 @@ func (s *Server) fetchChartFromCache(repo types.NamespacedName, chartName string
 				"unexpected value fetched from cache: %v", charts)
 		} else {
 			for _, chart := range typedCharts {
-				if chart.Name == chartName {
+				if chart.Name == chartSpec {
 					return &chart, nil // found it
 				}
 			}
@@ -287,7 +298,7 @@ func isValidChart(chart *models.Chart) (bool, error) {
 // note that chartVersion here could be a semver constraint expression, e.g. something like "<= 6.7.1",
 // as opposed to a simple literal expression, like "1.2.3"
 // see https://github.com/Masterminds/semver/blob/master/README.md#checking-version-constraints
-func findUrlForChartInList(chartList *unstructured.UnstructuredList, repoName, chartName, chartVersion string) (string, error) {
+func findUrlForChartInList(chartList *unstructured.UnstructuredList, sourceKind, repoName, chartName, chartVersion string) (string, error) {
 	var semVerConstraints *semver.Constraints
 	if chartVersion != "" {
 		var err error
@@ -298,8 +309,12 @@ func findUrlForChartInList(chartList *unstructured.UnstructuredList, repoName, c
 	for _, unstructuredChart := range chartList.Items {
 		thisChartName, found, err := unstructured.NestedString(unstructuredChart.Object, "spec", "chart")
 		thisRepoName, found2, err2 := unstructured.NestedString(unstructuredChart.Object, "spec", "sourceRef", "name")
+		thisSourceKind, _, _ := unstructured.NestedString(unstructuredChart.Object, "spec", "sourceRef", "kind")
+		if thisSourceKind == "" {
+			thisSourceKind = fluxHelmRepository
+		}
 
-		if err == nil && err2 == nil && found && found2 && repoName == thisRepoName && chartName == thisChartName {
+		if err == nil && err2 == nil && found && found2 && repoName == thisRepoName && chartName == thisChartName && sourceKind == thisSourceKind {
 			if done, success, reason := isChartPullComplete(unstructuredChart.Object); done {
 				if success {
 					if url, found, err := unstructured.NestedString(unstructuredChart.Object, "status", "url"); err != nil || !found {
@@ -415,19 +430,15 @@ func passesFilter(chart models.Chart, filters *corev1.FilterOptions) bool {
 	return ok
 }
 
-func filterAndPaginateCharts(filters *corev1.FilterOptions, pageSize int32, pageOffset int, cachedCharts map[string]interface{}) ([]*corev1.AvailablePackageSummary, error) {
+func filterAndPaginateCharts(filters *corev1.FilterOptions, sortOptions *corev1.SortOptions, pageSize int32, pageOffset int, cachedCharts map[string]interface{}) ([]*corev1.AvailablePackageSummary, error) {
 	// this loop is here for 3 reasons:
 	// 1) to convert from []interface{} which is what the generic cache implementation
 	// returns for cache hits to a typed array object.
 	// 2) perform any filtering of the results as needed, pending redis support for
 	// querying values stored in cache (see discussion in https://github.com/kubeapps/kubeapps/issues/3032)
-	// 3) if pagination was requested, only return up to one page size of results
+	// 3) sort the results, since the cache does not return them in any particular
+	// order, so that the core server can merge them with the results of other plugins
 	summaries := make([]*corev1.AvailablePackageSummary, 0)
-	i := 0
-	startAt := -1
-	if pageSize > 0 {
-		startAt = int(pageSize) * pageOffset
-	}
 	for _, packages := range cachedCharts {
 		if packages == nil {
 			continue
@@ -440,40 +451,44 @@ func filterAndPaginateCharts(filters *corev1.FilterOptions, pageSize int32, page
 		} else {
 			for _, chart := range typedCharts {
 				if passesFilter(chart, filters) {
-					i++
-					if startAt < i {
-						pkg, err := availablePackageSummaryFromChart(&chart)
-						if err != nil {
-							return nil, status.Errorf(
-								codes.Internal,
-								"Unable to parse chart to an AvailablePackageSummary: %v",
-								err)
-						}
-						summaries = append(summaries, pkg)
-						if pageSize > 0 && len(summaries) == int(pageSize) {
-							return summaries, nil
-						}
+					pkg, err := availablePackageSummaryFromChart(&chart)
+					if err != nil {
+						return nil, status.Errorf(
+							codes.Internal,
+							"Unable to parse chart to an AvailablePackageSummary: %v",
+							err)
 					}
+					summaries = append(summaries, pkg)
 				}
 			}
 		}
 	}
+	server.SortAvailablePackageSummaries(summaries, sortOptions)
+
+	// if pagination was requested, only return up to one page size of results
+	if pageOffset > len(summaries) {
+		pageOffset = len(summaries)
+	}
+	summaries = summaries[pageOffset:]
+	if pageSize > 0 && len(summaries) > int(pageSize) {
+		summaries = summaries[:pageSize]
+	}
 	return summaries, nil
 }
 
-func newFluxHelmChart(chartName, repoName, version string) unstructured.Unstructured {
+func newFluxHelmChart(sourceKind, chartName, repoName, version string) unstructured.Unstructured {
 	unstructuredChart := unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": fmt.Sprintf("%s/%s", fluxGroup, fluxVersion),
 			"kind":       fluxHelmChart,
 			"metadata": map[string]interface{}{
-				"generateName": fmt.Sprintf("%s-", chartName),
+				"generateName": fmt.Sprintf("%s-", chartNameFromSourceRef(sourceKind, repoName, chartName)),
 			},
 			"spec": map[string]interface{}{
 				"chart": chartName,
 				"sourceRef": map[string]interface{}{
 					"name": repoName,
-					"kind": fluxHelmRepository,
+					"kind": sourceKind,
 				},
 				"interval": "10m",
 			},
@@ -744,7 +744,7 @@ func newServerWithRepoAndCharts(repo runtime.Object, charts ...runtime.Object) (
 
 	apiextIfc := apiextfake.NewSimpleClientset(fluxHelmRepositoryCRD)
 
-	clientGetter := func(context.Context) (dynamic.Interface, apiext.Interface, error) {
+	clientGetter := func(context.Context, string) (dynamic.Interface, apiext.Interface, error) {
 		return dynamicClient, apiextIfc, nil
 	}
 
@@ -38,9 +38,13 @@ func init() {
 
 // RegisterWithGRPCServer enables a plugin to register with a gRPC server
 // returning the server implementation.
-func RegisterWithGRPCServer(s grpc.ServiceRegistrar, configGetter server.KubernetesConfigGetter, clustersConfig kube.ClustersConfig) (interface{}, error) {
+func RegisterWithGRPCServer(s grpc.ServiceRegistrar, configGetter server.KubernetesConfigGetter, clustersConfig kube.ClustersConfig, pluginsConfig server.PluginsConfig) (interface{}, error) {
 	log.Infof("+fluxv2 RegisterWithGRPCServer")
-	svr, err := NewServer(configGetter)
+	storageForDriver, err := pluginsConfig.Helm.StorageForDriver(clustersConfig)
+	if err != nil {
+		return nil, err
+	}
+	svr, err := NewServer(configGetter, clustersConfig, storageForDriver)
 	if err != nil {
 		return nil, err
 	}
@@ -14,25 +14,35 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
+	"sort"
 	"time"
 
+	"github.com/ghodss/yaml"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/plugins/pkg/statuserror"
+	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/server"
 	"github.com/kubeapps/kubeapps/pkg/chart/models"
+	"github.com/kubeapps/kubeapps/pkg/helm"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	"helm.sh/helm/v3/pkg/strvals"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	log "k8s.io/klog/v2"
 )
 
 const (
@@ -44,8 +54,8 @@ const (
 	fluxHelmReleaseList    = "HelmReleaseList"
 )
 
-func (s *Server) getReleasesResourceInterface(ctx context.Context, namespace string) (dynamic.ResourceInterface, error) {
-	client, err := s.getDynamicClient(ctx)
+func (s *Server) getReleasesResourceInterface(ctx context.Context, cluster string, namespace string) (dynamic.ResourceInterface, error) {
+	client, err := s.getDynamicClient(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +70,8 @@ func (s *Server) getReleasesResourceInterface(ctx context.Context, namespace str
 }
 
 // namespace maybe "", in which case releases from all namespaces are returned
-func (s *Server) listReleasesInCluster(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
-	releasesIfc, err := s.getReleasesResourceInterface(ctx, namespace)
+func (s *Server) listReleasesInCluster(ctx context.Context, cluster string, namespace string) (*unstructured.UnstructuredList, error) {
+	releasesIfc, err := s.getReleasesResourceInterface(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -72,18 +82,21 @@ func (s *Server) listReleasesInCluster(ctx context.Context, namespace string) (*
 	}
 }
 
-func (s *Server) getReleaseInCluster(ctx context.Context, name types.NamespacedName) (*unstructured.Unstructured, error) {
-	releasesIfc, err := s.getReleasesResourceInterface(ctx, name.Namespace)
+func (s *Server) getReleaseInCluster(ctx context.Context, cluster string, name types.NamespacedName) (*unstructured.Unstructured, error) {
+	releasesIfc, err := s.getReleasesResourceInterface(ctx, cluster, name.Namespace)
 	if err != nil {
 		return nil, err
 	}
 	return releasesIfc.Get(ctx, name.Name, metav1.GetOptions{})
 }
 
-func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace string, pageSize int32, pageOffset int) ([]*corev1.InstalledPackageSummary, error) {
-	releasesFromCluster, err := s.listReleasesInCluster(ctx, namespace)
+// paginatedInstalledPkgSummaries returns one page of the installed packages
+// starting at the release with the given offset, together with the offset of
+// the next release when the page is full or zero otherwise.
+func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, cluster string, namespace string, pageSize int32, pageOffset int) ([]*corev1.InstalledPackageSummary, int, error) {
+	releasesFromCluster, err := s.listReleasesInCluster(ctx, cluster, namespace)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	installedPkgSummaries := []*corev1.InstalledPackageSummary{}
@@ -91,36 +104,55 @@ func (s *Server) paginatedInstalledPkgSummaries(ctx context.Context, namespace s
 		// we're going to need this later
 		// TODO (gfichtenholt) for now we get all charts and later find one that helmrelease is using
 		// there is probably a more efficient way to do this
-		chartsFromCluster, err := s.listChartsInCluster(ctx, apiv1.NamespaceAll)
+		chartsFromCluster, err := s.listChartsInCluster(ctx, cluster, apiv1.NamespaceAll)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
-		startAt := -1
-		if pageSize > 0 {
-			startAt = int(pageSize) * pageOffset
-		}
+		sortReleases(releasesFromCluster.Items)
 
 		for i, releaseUnstructured := range releasesFromCluster.Items {
-			if startAt <= i {
-				summary, err := s.installedPkgSummaryFromRelease(releaseUnstructured.Object, chartsFromCluster)
+			if pageOffset <= i {
+				summary, err := s.installedPkgSummaryFromRelease(cluster, releaseUnstructured.Object, chartsFromCluster)
 				if err != nil {
-					return nil, err
+					return nil, 0, err
 				} else if summary == nil {
 					// not ready yet
 					continue
 				}
 				installedPkgSummaries = append(installedPkgSummaries, summary)
 				if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
-					break
+					return installedPkgSummaries, i + 1, nil
 				}
 			}
 		}
 	}
-	return installedPkgSummaries, nil
+	return installedPkgSummaries, 0, nil
+}
+
+// sortReleases sorts the releases in the order of the core server, so that it
+// can merge them with the installed packages of other plugins
+func sortReleases(releases []unstructured.Unstructured) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		return server.CompareInstalledPackageSummaries(
+			installedPkgSortKey(&releases[i]),
+			installedPkgSortKey(&releases[j])) < 0
+	})
+}
+
+// installedPkgSortKey returns the fields of the installed package summary of
+// a release which the core server sorts by
+func installedPkgSortKey(release *unstructured.Unstructured) *corev1.InstalledPackageSummary {
+	return &corev1.InstalledPackageSummary{
+		Name: release.GetName(),
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context:    &corev1.Context{Namespace: release.GetNamespace()},
+			Identifier: release.GetName(),
+		},
+	}
 }
 
-func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]interface{}, chartsFromCluster *unstructured.UnstructuredList) (*corev1.InstalledPackageSummary, error) {
+func (s *Server) installedPkgSummaryFromRelease(cluster string, unstructuredRelease map[string]interface{}, chartsFromCluster *unstructured.UnstructuredList) (*corev1.InstalledPackageSummary, error) {
 	// first check if release CR is ready or is in "flux"
 	if !checkGeneration(unstructuredRelease) {
 		return nil, nil
@@ -144,6 +176,10 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 	repoNamespace, _, _ := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "sourceRef", "namespace")
 	chartName, _, _ := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "chart")
 	chartVersion, _, _ := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "version")
+	sourceKind, _, _ := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "sourceRef", "kind")
+	if sourceKind == "" {
+		sourceKind = fluxHelmRepository
+	}
 
 	var latestPkgVersion *corev1.PackageAppVersion
 	var pkgDetail *corev1.AvailablePackageDetail
@@ -153,13 +189,14 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 		// e.g. "default-my-nginx". The spec somewhat vaguely states "The name of the chart as made available
 		// by the HelmRepository (without any aliases), for example: podinfo". So, we can't exactly do a "get"
 		// on the name, but have to iterate the complete list of available charts for a match
-		tarUrl, err := findUrlForChartInList(chartsFromCluster, repoName, chartName, chartVersion)
+		tarUrl, err := findUrlForChartInList(chartsFromCluster, sourceKind, repoName, chartName, chartVersion)
 		if err != nil {
 			return nil, err
 		} else if tarUrl == "" {
 			return nil, status.Errorf(codes.Internal, "Failed to find find tar file url for chart [%s], version: [%s]", chartName, chartVersion)
 		}
-		chartID := fmt.Sprintf("%s/%s", repoName, chartName)
+		// for GitRepositories and Buckets, chartName is the path of the chart within the artifact
+		chartID := chartIDFromSourceRef(sourceKind, repoName, chartName)
 		if pkgDetail, err = availablePackageDetailFromTarball(chartID, tarUrl); err != nil {
 			return nil, err
 		}
@@ -169,7 +206,7 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 			repoNamespace = name.Namespace
 		}
 		repo := types.NamespacedName{Namespace: repoNamespace, Name: repoName}
-		chartFromCache, err := s.fetchChartFromCache(repo, chartName)
+		chartFromCache, err := s.fetchChartFromCache(cluster, sourceKind, repo, chartName)
 		if err != nil {
 			return nil, err
 		} else if chartFromCache != nil && len(chartFromCache.ChartVersions) > 0 {
@@ -184,6 +221,7 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 	return &corev1.InstalledPackageSummary{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
+				Cluster:   cluster,
 				Namespace: name.Namespace,
 			},
 			Identifier: name.Name,
@@ -206,10 +244,10 @@ func (s *Server) installedPkgSummaryFromRelease(unstructuredRelease map[string]i
 	}, nil
 }
 
-func (s *Server) installedPackageDetail(ctx context.Context, name types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
-	unstructuredRelease, err := s.getReleaseInCluster(ctx, name)
+func (s *Server) installedPackageDetail(ctx context.Context, cluster string, name types.NamespacedName) (*corev1.InstalledPackageDetail, error) {
+	unstructuredRelease, err := s.getReleaseInCluster(ctx, cluster, name)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q due to: %v", name, err)
+		return nil, statuserror.FromK8sError("get", "HelmRelease", name.String(), err)
 	}
 
 	var pkgVersionRef *corev1.VersionReference
@@ -220,17 +258,14 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 		}
 	}
 
-	valuesApplied := ""
-	valuesMap, found, err := unstructured.NestedMap(unstructuredRelease.Object, "spec", "values")
-	if found && err == nil && len(valuesMap) != 0 {
-		bytes, err := json.Marshal(valuesMap)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Unable to marshal Helm release values due to: %v", err)
-		}
-		valuesApplied = string(bytes)
+	valuesApplied, err := valuesAppliedFromUnstructured(name, unstructuredRelease.Object)
+	if err != nil {
+		return nil, err
+	}
+	valuesResolved, valuesOrigins, valuesResolvedPartial, err := s.valuesResolvedFromUnstructured(ctx, cluster, name, unstructuredRelease.Object)
+	if err != nil {
+		return nil, err
 	}
-	// TODO (gfichtenholt) what about ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`?
-	// ValuesReference maybe a config map or a secret
 
 	// this will only be present if install/upgrade succeeded
 	pkgVersion, found, err := unstructured.NestedString(unstructuredRelease.Object, "status", "lastAppliedRevision")
@@ -243,8 +278,10 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 	if err != nil {
 		return nil, err
 	}
+	// the HelmRepository referenced by a HelmRelease lives on the same cluster
+	availablePackageRef.Context.Cluster = cluster
 
-	release, err := s.helmReleaseFromUnstructured(ctx, name, unstructuredRelease.Object)
+	release, err := s.helmReleaseFromUnstructured(ctx, cluster, name, unstructuredRelease.Object)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +299,7 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 	return &corev1.InstalledPackageDetail{
 		InstalledPackageRef: &corev1.InstalledPackageReference{
 			Context: &corev1.Context{
+				Cluster:   cluster,
 				Namespace: name.Namespace,
 			},
 			Identifier: name.Name,
@@ -274,6 +312,9 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 			AppVersion: appVersion,
 		},
 		ValuesApplied:         valuesApplied,
+		ValuesResolved:        valuesResolved,
+		ValuesOrigins:         valuesOrigins,
+		ValuesResolvedPartial: valuesResolvedPartial,
 		ReconciliationOptions: installedPackageReconciliationOptionsFromUnstructured(unstructuredRelease.Object),
 		AvailablePackageRef:   availablePackageRef,
 		PostInstallationNotes: postInstallNotes,
@@ -281,7 +322,7 @@ func (s *Server) installedPackageDetail(ctx context.Context, name types.Namespac
 	}, nil
 }
 
-func (s *Server) helmReleaseFromUnstructured(ctx context.Context, name types.NamespacedName, unstructuredRelease map[string]interface{}) (*release.Release, error) {
+func (s *Server) helmReleaseFromUnstructured(ctx context.Context, cluster string, name types.NamespacedName, unstructuredRelease map[string]interface{}) (*release.Release, error) {
 	// post installation notes can only be retrieved via helm APIs, flux doesn't do it
 	// see discussion in https://cloud-native.slack.com/archives/CLAJ40HV3/p1629244025187100
 	if s.actionConfigGetter == nil {
@@ -300,7 +341,7 @@ func (s *Server) helmReleaseFromUnstructured(ctx context.Context, name types.Nam
 		helmReleaseName = fmt.Sprintf("%s-%s", targetNamespace, name.Name)
 	}
 
-	actionConfig, err := s.actionConfigGetter(ctx, name.Namespace)
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, name.Namespace)
 	if err != nil || actionConfig == nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
@@ -315,10 +356,10 @@ func (s *Server) helmReleaseFromUnstructured(ctx context.Context, name types.Nam
 	return release, nil
 }
 
-func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName) (*corev1.InstalledPackageReference, error) {
+func (s *Server) newRelease(ctx context.Context, cluster string, packageRef *corev1.AvailablePackageReference, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
 	// HACK: just for now assume HelmRelease CRD will live in the kubeapps namespace
 	kubeappsNamespace := os.Getenv("POD_NAMESPACE")
-	resourceIfc, err := s.getReleasesResourceInterface(ctx, kubeappsNamespace)
+	resourceIfc, err := s.getReleasesResourceInterface(ctx, cluster, kubeappsNamespace)
 	if err != nil {
 		return nil, err
 	}
@@ -333,14 +374,25 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 		return nil, err
 	}
 
-	packageIdParts := strings.Split(unescapedChartID, "/")
-	repo := types.NamespacedName{Namespace: availablePackageNamespace, Name: packageIdParts[0]}
-	chart, err := s.fetchChartFromCache(repo, packageIdParts[1])
+	sourceKind, sourceName, chartSpec, err := sourceRefFromChartID(unescapedChartID)
+	if err != nil {
+		return nil, err
+	}
+	repo := types.NamespacedName{Namespace: availablePackageNamespace, Name: sourceName}
+	chart, err := s.fetchChartFromCache(cluster, sourceKind, repo, chartSpec)
 	if err != nil {
 		return nil, err
 	}
 
-	fluxHelmRelease := newFluxHelmRelease(chart, kubeappsNamespace, targetName)
+	values, err := valuesFromString(valuesString)
+	if err != nil {
+		return nil, err
+	}
+
+	fluxHelmRelease, err := newFluxHelmRelease(chart, kubeappsNamespace, targetName, versionRef, reconcile, values)
+	if err != nil {
+		return nil, err
+	}
 	newRelease, err := resourceIfc.Create(ctx, fluxHelmRelease, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
@@ -351,13 +403,418 @@ func (s *Server) newRelease(ctx context.Context, packageRef *corev1.AvailablePac
 		return nil, err
 	}
 	return &corev1.InstalledPackageReference{
-		Context:    &corev1.Context{Namespace: name.Namespace},
+		Context:    &corev1.Context{Cluster: cluster, Namespace: name.Namespace},
 		Identifier: name.Name,
 	}, nil
 }
 
+// updateRelease patches the spec of an existing flux HelmRelease with the
+// requested version, values and reconciliation options. Flux will then notice
+// the new generation and reconcile the release accordingly.
+func (s *Server) updateRelease(ctx context.Context, cluster string, name types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, valuesString string) (*corev1.InstalledPackageReference, error) {
+	resourceIfc, err := s.getReleasesResourceInterface(ctx, cluster, name.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	unstructuredRel, err := resourceIfc.Get(ctx, name.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q due to: %v", name, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get Helm release %q due to: %v", name, err)
+	}
+
+	// as with the helm plugin, a version or values omitted from the request
+	// leave those of the release unchanged
+	if versionRef.GetVersion() != "" {
+		err = unstructured.SetNestedField(unstructuredRel.Object, versionRef.GetVersion(), "spec", "chart", "spec", "version")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to set the version of Helm release %q due to: %v", name, err)
+		}
+	}
+
+	values, err := valuesFromString(valuesString)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 0 {
+		err = unstructured.SetNestedMap(unstructuredRel.Object, values, "spec", "values")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to set the values of Helm release %q due to: %v", name, err)
+		}
+	}
+
+	if reconcile != nil {
+		if err = setReconciliationOptions(unstructuredRel.Object, reconcile); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedRelease, err := resourceIfc.Update(ctx, unstructuredRel, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to update Helm release %q due to: %v", name, err)
+	}
+
+	updatedName, err := namespacedName(updatedRelease.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Cluster: cluster, Namespace: updatedName.Namespace},
+		Identifier: updatedName.Name,
+		Plugin:     GetPluginDetail(),
+	}, nil
+}
+
+// deleteRelease deletes the flux HelmRelease CR, which in turn causes the
+// helm-controller to uninstall the corresponding helm release.
+func (s *Server) deleteRelease(ctx context.Context, cluster string, name types.NamespacedName) error {
+	resourceIfc, err := s.getReleasesResourceInterface(ctx, cluster, name.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if err = resourceIfc.Delete(ctx, name.Name, metav1.DeleteOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "Unable to find Helm release %q due to: %v", name, err)
+		}
+		return status.Errorf(codes.Internal, "Unable to delete Helm release %q due to: %v", name, err)
+	}
+	return nil
+}
+
+// resourceRefsForRelease returns the references for the resources in the
+// manifest of the helm release which the flux HelmRelease CR reconciles.
+func (s *Server) resourceRefsForRelease(ctx context.Context, cluster string, name types.NamespacedName) ([]*corev1.ResourceRef, error) {
+	unstructuredRelease, err := s.getReleaseInCluster(ctx, cluster, name)
+	if err != nil {
+		return nil, statuserror.FromK8sError("get", "HelmRelease", name.String(), err)
+	}
+
+	release, err := s.helmReleaseFromUnstructured(ctx, cluster, name, unstructuredRelease.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := server.ResourceRefsFromManifest(release.Manifest, release.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to parse the manifest of Helm release %q due to: %v", name, err)
+	}
+	return refs, nil
+}
+
+// watchReleases sends the status of the releases in the namespace, or of the
+// named release only, each time it changes until the stream is closed.
+// namespace maybe "", in which case releases from all namespaces are watched
+func (s *Server) watchReleases(stream corev1.PackagesService_WatchInstalledPackagesServer, cluster, namespace, name string) error {
+	ctx := stream.Context()
+	releasesIfc, err := s.getReleasesResourceInterface(ctx, cluster, namespace)
+	if err != nil {
+		return err
+	}
+	listOptions := metav1.ListOptions{}
+	if name != "" {
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	}
+	watcher, err := releasesIfc.Watch(ctx, listOptions)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to watch fluxv2 helmreleases: %v", err)
+	}
+	defer watcher.Stop()
+
+	sender := server.NewInstalledPackageStatusSender(stream)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "watch of fluxv2 helmreleases was closed")
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "unable to watch fluxv2 helmreleases: %v", errors.FromObject(event.Object))
+			}
+			unstructuredRelease, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			ref := &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Cluster:   cluster,
+					Namespace: unstructuredRelease.GetNamespace(),
+				},
+				Identifier: unstructuredRelease.GetName(),
+			}
+			if event.Type == watch.Deleted {
+				err = sender.SendDeleted(ref)
+			} else {
+				err = sender.Send(ref, installedPackageStatusFromUnstructured(unstructuredRelease.Object))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// valuesSource holds the values read from one of the sources of the values
+// of a HelmRelease, together with the origin of those values.
+type valuesSource struct {
+	values map[string]interface{}
+	origin *corev1.ValueOrigin
+}
+
+// valuesAppliedFromUnstructured returns the inline spec.values of a
+// HelmRelease, which are those replaced by an update of the release.
+func valuesAppliedFromUnstructured(name types.NamespacedName, unstructuredRelease map[string]interface{}) (string, error) {
+	values, _, err := unstructured.NestedMap(unstructuredRelease, "spec", "values")
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to read the values of Helm release %q due to: %v", name, err)
+	}
+	return marshalValues(values)
+}
+
+// valuesResolvedFromUnstructured returns the values of a HelmRelease merged
+// in the same order as the helm-controller does: the spec.valuesFrom
+// references in order, then the inline spec.values. See
+// https://fluxcd.io/docs/components/helm/helmreleases/#values-overrides
+// The references are read with the credentials of the user, so the values of
+// those which the user cannot read are left out and reported as redacted. The
+// values and their origins are only returned when the release has references.
+// The values are reported as partial when those of a redacted reference, or of
+// a required reference which could not be read, are left out.
+func (s *Server) valuesResolvedFromUnstructured(ctx context.Context, cluster string, name types.NamespacedName, unstructuredRelease map[string]interface{}) (string, []*corev1.ValueOrigin, bool, error) {
+	sources := []valuesSource{}
+	redactedOrigins := []*corev1.ValueOrigin{}
+	partial := false
+
+	references, _, err := unstructured.NestedSlice(unstructuredRelease, "spec", "valuesFrom")
+	if err != nil {
+		return "", nil, false, status.Errorf(codes.Internal, "Unable to read the values references of Helm release %q due to: %v", name, err)
+	}
+	if len(references) == 0 {
+		return "", nil, false, nil
+	}
+	client, err := s.getDynamicClient(ctx, cluster)
+	if err != nil {
+		return "", nil, false, err
+	}
+	for _, ref := range references {
+		reference, ok := ref.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		source, err := valuesSourceForReference(ctx, client, name.Namespace, reference)
+		if err != nil {
+			return "", nil, false, err
+		}
+		if source == nil {
+			// the helm-controller also skips the optional references
+			// which cannot be found, but not the required ones.
+			if optional, _, _ := unstructured.NestedBool(reference, "optional"); !optional {
+				partial = true
+			}
+		} else if source.origin.Redacted {
+			redactedOrigins = append(redactedOrigins, source.origin)
+			partial = true
+		} else {
+			sources = append(sources, *source)
+		}
+	}
+
+	values, _, err := unstructured.NestedMap(unstructuredRelease, "spec", "values")
+	if err != nil {
+		return "", nil, false, status.Errorf(codes.Internal, "Unable to read the values of Helm release %q due to: %v", name, err)
+	}
+	if len(values) != 0 {
+		sources = append(sources, valuesSource{
+			values: values,
+			origin: &corev1.ValueOrigin{Source: &corev1.ValueOrigin_Inline{Inline: true}},
+		})
+	}
+
+	merged := map[string]interface{}{}
+	for _, source := range sources {
+		merged = helm.MergeValues(merged, source.values)
+	}
+
+	valuesResolved, err := marshalValues(merged)
+	if err != nil {
+		return "", nil, false, err
+	}
+	return valuesResolved, append(valuesOrigins(merged, sources), redactedOrigins...), partial, nil
+}
+
+// marshalValues returns the values as a json string, which is empty when there
+// are no values.
+func marshalValues(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(values)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to marshal Helm release values due to: %v", err)
+	}
+	return string(bytes), nil
+}
+
+// valuesSourceForReference reads the values of a HelmRelease valuesFrom
+// reference. It returns nil when the reference cannot be found or is not
+// supported, which the status of the HelmRelease reports for required
+// references, and a source without values marked as redacted when the user
+// cannot read it.
+func valuesSourceForReference(ctx context.Context, client dynamic.Interface, namespace string, reference map[string]interface{}) (*valuesSource, error) {
+	kind, _, _ := unstructured.NestedString(reference, "kind")
+	name, _, _ := unstructured.NestedString(reference, "name")
+	valuesKey, _, _ := unstructured.NestedString(reference, "valuesKey")
+	targetPath, _, _ := unstructured.NestedString(reference, "targetPath")
+	if valuesKey == "" {
+		valuesKey = "values.yaml"
+	}
+
+	origin := &corev1.ValueOrigin{Path: targetPath}
+	keyRef := &corev1.ValueSourceKeyRef{Name: name, Key: valuesKey}
+	resource := schema.GroupVersionResource{Version: "v1"}
+	switch kind {
+	case "ConfigMap":
+		resource.Resource = "configmaps"
+		origin.Source = &corev1.ValueOrigin_ConfigMapKeyRef{ConfigMapKeyRef: keyRef}
+	case "Secret":
+		resource.Resource = "secrets"
+		origin.Source = &corev1.ValueOrigin_SecretKeyRef{SecretKeyRef: keyRef}
+	default:
+		log.Errorf("Unsupported kind [%s] of values reference [%s]", kind, name)
+		return nil, nil
+	}
+
+	unstructuredSource, err := client.Resource(resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Infof("Skipping values reference to %s [%s] which was not found", kind, name)
+			return nil, nil
+		} else if errors.IsForbidden(err) || errors.IsUnauthorized(err) {
+			origin.Redacted = true
+			return &valuesSource{origin: origin}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get %s %q due to: %v", kind, name, err)
+	}
+
+	data, found, err := unstructured.NestedString(unstructuredSource.Object, "data", valuesKey)
+	if err != nil || !found {
+		log.Infof("Skipping values reference to key [%s] which was not found in %s [%s]", valuesKey, kind, name)
+		return nil, nil
+	}
+	if kind == "Secret" {
+		bytes, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to decode key %q of Secret %q due to: %v", valuesKey, name, err)
+		}
+		data = string(bytes)
+	}
+
+	values := map[string]interface{}{}
+	if targetPath != "" {
+		// the helm-controller sets the value at the target path just like
+		// the --set flag of the helm CLI would
+		if err = strvals.ParseInto(fmt.Sprintf("%s=%s", targetPath, data), values); err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to set the value of key %q of %s %q at path %q due to: %v", valuesKey, kind, name, targetPath, err)
+		}
+	} else if err = yaml.Unmarshal([]byte(data), &values); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to parse the values of key %q of %s %q due to: %v", valuesKey, kind, name, err)
+	}
+	return &valuesSource{values: values, origin: origin}, nil
+}
+
+// valuesOrigins returns the origin of each of the leaf values of the merged
+// values, sorted by path, which is the last of the sources setting the value.
+func valuesOrigins(merged map[string]interface{}, sources []valuesSource) []*corev1.ValueOrigin {
+	sourcePaths := make([]map[string]bool, len(sources))
+	for i, source := range sources {
+		sourcePaths[i] = map[string]bool{}
+		addValuesPaths("", source.values, sourcePaths[i])
+	}
+	mergedPaths := map[string]bool{}
+	addValuesPaths("", merged, mergedPaths)
+
+	paths := make([]string, 0, len(mergedPaths))
+	for path := range mergedPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	origins := []*corev1.ValueOrigin{}
+	for _, path := range paths {
+		for i := len(sources) - 1; i >= 0; i-- {
+			if sourcePaths[i][path] {
+				origins = append(origins, &corev1.ValueOrigin{
+					Path:   path,
+					Source: sources[i].origin.Source,
+				})
+				break
+			}
+		}
+	}
+	return origins
+}
+
+// addValuesPaths adds the dot-separated paths of the leaf values to paths.
+func addValuesPaths(prefix string, values map[string]interface{}, paths map[string]bool) {
+	for k, v := range values {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			addValuesPaths(path, nested, paths)
+		} else {
+			paths[path] = true
+		}
+	}
+}
+
+// valuesFromString parses the values provided by the user, either as JSON
+// or YAML, into a map suitable for the HelmRelease spec.values field.
+func valuesFromString(valuesString string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if valuesString == "" {
+		return values, nil
+	}
+	if err := yaml.Unmarshal([]byte(valuesString), &values); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse the values due to: %v", err)
+	}
+	return values, nil
+}
+
+// setReconciliationOptions is the inverse of installedPackageReconciliationOptionsFromUnstructured,
+// mapping the core ReconciliationOptions onto the HelmRelease spec.
+func setReconciliationOptions(unstructuredRelease map[string]interface{}, reconcile *corev1.ReconciliationOptions) error {
+	if reconcile.Interval > 0 {
+		interval := (time.Duration(reconcile.Interval) * time.Second).String()
+		if err := unstructured.SetNestedField(unstructuredRelease, interval, "spec", "interval"); err != nil {
+			return status.Errorf(codes.Internal, "Unable to set the reconciliation interval due to: %v", err)
+		}
+	}
+	if err := unstructured.SetNestedField(unstructuredRelease, reconcile.Suspend, "spec", "suspend"); err != nil {
+		return status.Errorf(codes.Internal, "Unable to set the reconciliation suspend flag due to: %v", err)
+	}
+	if reconcile.ServiceAccountName != "" {
+		if err := unstructured.SetNestedField(unstructuredRelease, reconcile.ServiceAccountName, "spec", "serviceAccountName"); err != nil {
+			return status.Errorf(codes.Internal, "Unable to set the reconciliation service account due to: %v", err)
+		}
+	} else {
+		unstructured.RemoveNestedField(unstructuredRelease, "spec", "serviceAccountName")
+	}
+	return nil
+}
+
 func installedPackageStatusFromUnstructured(unstructuredRelease map[string]interface{}) *corev1.InstalledPackageStatus {
 	complete, success, reason := checkStatusReady(unstructuredRelease)
+	// the Ready condition is stale until the controller observes the latest
+	// generation of the release
+	if !checkGeneration(unstructuredRelease) {
+		complete = false
+	}
 	status := &corev1.InstalledPackageStatus{
 		Ready:      complete && success,
 		UserReason: reason,
@@ -397,6 +854,7 @@ func installedPackageAvailablePackageRefFromUnstructured(unstructuredRelease map
 	if !found || err != nil {
 		return nil, status.Errorf(codes.Internal, "missing required field spec.chart.spec.chart")
 	}
+	sourceKind, _, _ := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "sourceRef", "kind")
 	repoNamespace, found, err := unstructured.NestedString(unstructuredRelease, "spec", "chart", "spec", "sourceRef", "namespace")
 	// CrossNamespaceObjectReference namespace is optional, so
 	if !found || err != nil || repoNamespace == "" {
@@ -407,17 +865,21 @@ func installedPackageAvailablePackageRefFromUnstructured(unstructuredRelease map
 		repoNamespace = name.Namespace
 	}
 	return &corev1.AvailablePackageReference{
-		Identifier: fmt.Sprintf("%s/%s", repoName, chartName),
+		Identifier: chartIDFromSourceRef(sourceKind, repoName, chartName),
 		Plugin:     GetPluginDetail(),
 		Context:    &corev1.Context{Namespace: repoNamespace},
 	}, nil
 }
 
+// newFluxHelmRelease returns a HelmRelease for the chart, with the requested
+// version constraint, values and reconciliation options mapped onto its spec
+// as for updates.
 // Potentially, there are 3 different namespaces that can be specified here
 // 1. spec.chart.spec.sourceRef.namespace, where HelmRepository CRD object referenced exists
 // 2. metadata.namespace, where this HelmRelease CRD will exist
 // 3. spec.targetNamespace, where flux will install any artifacts from the release
-func newFluxHelmRelease(chart *models.Chart, releaseNamespace string, targetName types.NamespacedName) *unstructured.Unstructured {
+func newFluxHelmRelease(chart *models.Chart, releaseNamespace string, targetName types.NamespacedName, versionRef *corev1.VersionReference, reconcile *corev1.ReconciliationOptions, values map[string]interface{}) (*unstructured.Unstructured, error) {
+	sourceKind, chartSpec := chartSourceRef(chart)
 	unstructuredRel := unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": fmt.Sprintf("%s/%s", fluxHelmReleaseGroup, fluxHelmReleaseVersion),
@@ -429,11 +891,10 @@ func newFluxHelmRelease(chart *models.Chart, releaseNamespace string, targetName
 			"spec": map[string]interface{}{
 				"chart": map[string]interface{}{
 					"spec": map[string]interface{}{
-						"chart":   chart.Name,
-						"version": "*",
+						"chart": chartSpec,
 						"sourceRef": map[string]interface{}{
 							"name":      chart.Repo.Name,
-							"kind":      fluxHelmRepository,
+							"kind":      sourceKind,
 							"namespace": chart.Repo.Namespace,
 						},
 					},
@@ -443,9 +904,28 @@ func newFluxHelmRelease(chart *models.Chart, releaseNamespace string, targetName
 					"createNamespace": true,
 				},
 				"targetNamespace": targetName.Namespace,
-				// TODO: values
 			},
 		},
 	}
-	return &unstructuredRel
+
+	// according to docs the version is optional and defaults to '*' (i.e. latest)
+	// when omitted
+	if versionRef.GetVersion() != "" {
+		err := unstructured.SetNestedField(unstructuredRel.Object, versionRef.GetVersion(), "spec", "chart", "spec", "version")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to set the version of Helm release %q due to: %v", targetName, err)
+		}
+	}
+	if len(values) != 0 {
+		err := unstructured.SetNestedMap(unstructuredRel.Object, values, "spec", "values")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to set the values of Helm release %q due to: %v", targetName, err)
+		}
+	}
+	if reconcile != nil {
+		if err := setReconciliationOptions(unstructuredRel.Object, reconcile); err != nil {
+			return nil, err
+		}
+	}
+	return &unstructuredRel, nil
 }
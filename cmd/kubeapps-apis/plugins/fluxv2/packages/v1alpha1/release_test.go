@@ -15,18 +15,23 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	redismock "github.com/go-redis/redismock/v8"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	kubeutils "github.com/kubeapps/kubeapps/pkg/kube"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
@@ -38,9 +43,12 @@ import (
 	"helm.sh/helm/v3/pkg/storage/driver"
 	apiext "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
@@ -122,14 +130,14 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				Context: &corev1.Context{Namespace: ""},
 			},
 			existingObjs: []testSpecGetInstalledPackages{
-				redis_existing_spec_completed,
 				airflow_existing_spec_completed,
+				redis_existing_spec_completed,
 			},
 			expectedStatusCode: codes.OK,
 			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
 				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
-					redis_summary_installed,
 					airflow_summary_installed,
+					redis_summary_installed,
 				},
 			},
 		},
@@ -143,13 +151,13 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 			existingObjs: []testSpecGetInstalledPackages{
-				redis_existing_spec_completed,
 				airflow_existing_spec_completed,
+				redis_existing_spec_completed,
 			},
 			expectedStatusCode: codes.OK,
 			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
 				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
-					redis_summary_installed,
+					airflow_summary_installed,
 				},
 				NextPageToken: "1",
 			},
@@ -164,13 +172,13 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 			existingObjs: []testSpecGetInstalledPackages{
-				redis_existing_spec_completed,
 				airflow_existing_spec_completed,
+				redis_existing_spec_completed,
 			},
 			expectedStatusCode: codes.OK,
 			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
 				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
-					airflow_summary_installed,
+					redis_summary_installed,
 				},
 				NextPageToken: "2",
 			},
@@ -185,8 +193,8 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 			existingObjs: []testSpecGetInstalledPackages{
-				redis_existing_spec_completed,
 				airflow_existing_spec_completed,
+				redis_existing_spec_completed,
 			},
 			expectedStatusCode: codes.OK,
 			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
@@ -305,10 +313,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 					if err != nil {
 						t.Fatalf("%+v", err)
 					}
-					startAt := int(tc.request.GetPaginationOptions().GetPageSize()) * pageOffset
-					if i < startAt {
+					if i < pageOffset {
 						continue
-					} else if i >= startAt+int(tc.request.GetPaginationOptions().GetPageSize()) {
+					} else if i >= pageOffset+int(tc.request.GetPaginationOptions().GetPageSize()) {
 						break
 					}
 				}
@@ -359,11 +366,31 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestSortReleases(t *testing.T) {
+	releases := []unstructured.Unstructured{
+		*newRelease("redis", "namespace-2", nil, nil),
+		*newRelease("Redis", "namespace-1", nil, nil),
+		*newRelease("redis", "namespace-1", nil, nil),
+		*newRelease("airflow", "namespace-3", nil, nil),
+	}
+	sortReleases(releases)
+
+	got := []string{}
+	for _, r := range releases {
+		got = append(got, r.GetNamespace()+"/"+r.GetName())
+	}
+	want := []string{"namespace-1/Redis", "namespace-3/airflow", "namespace-1/redis", "namespace-2/redis"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
 type helmReleaseStub struct {
 	name         string
 	namespace    string
 	chartVersion string
 	notes        string
+	manifest     string
 	status       release.Status
 }
 
@@ -602,6 +629,205 @@ type testSpecCreateInstalledPackage struct {
 	chartTarGz    string
 }
 
+func TestValuesResolvedFromUnstructured(t *testing.T) {
+	configMapOrigin := func(name, key, path string) *corev1.ValueOrigin {
+		return &corev1.ValueOrigin{
+			Path:   path,
+			Source: &corev1.ValueOrigin_ConfigMapKeyRef{ConfigMapKeyRef: &corev1.ValueSourceKeyRef{Name: name, Key: key}},
+		}
+	}
+	secretOrigin := func(name, key, path string) *corev1.ValueOrigin {
+		return &corev1.ValueOrigin{
+			Path:   path,
+			Source: &corev1.ValueOrigin_SecretKeyRef{SecretKeyRef: &corev1.ValueSourceKeyRef{Name: name, Key: key}},
+		}
+	}
+	inlineOrigin := func(path string) *corev1.ValueOrigin {
+		return &corev1.ValueOrigin{
+			Path:   path,
+			Source: &corev1.ValueOrigin_Inline{Inline: true},
+		}
+	}
+
+	testCases := []struct {
+		name                   string
+		spec                   map[string]interface{}
+		expectedValuesApplied  string
+		expectedValuesResolved string
+		expectedOrigins        []*corev1.ValueOrigin
+		expectedPartial        bool
+	}{
+		{
+			name: "returns the inline values only without references",
+			spec: map[string]interface{}{
+				"values": map[string]interface{}{"a": "inline"},
+			},
+			expectedValuesApplied: `{"a":"inline"}`,
+		},
+		{
+			name: "merges the references in order and the inline values last",
+			spec: map[string]interface{}{
+				"valuesFrom": []interface{}{
+					map[string]interface{}{"kind": "ConfigMap", "name": "my-values"},
+					map[string]interface{}{"kind": "Secret", "name": "my-secret-values", "valuesKey": "secret.yaml"},
+					map[string]interface{}{"kind": "ConfigMap", "name": "my-values", "valuesKey": "tag", "targetPath": "image.tag"},
+				},
+				"values": map[string]interface{}{
+					"b": map[string]interface{}{"d": "inline"},
+				},
+			},
+			expectedValuesApplied:  `{"b":{"d":"inline"}}`,
+			expectedValuesResolved: `{"a":"configmap","b":{"c":"secret","d":"inline"},"image":{"tag":"v1"}}`,
+			expectedOrigins: []*corev1.ValueOrigin{
+				configMapOrigin("my-values", "values.yaml", "a"),
+				secretOrigin("my-secret-values", "secret.yaml", "b.c"),
+				inlineOrigin("b.d"),
+				configMapOrigin("my-values", "tag", "image.tag"),
+			},
+		},
+		{
+			name: "redacts the values of a secret which the user cannot read",
+			spec: map[string]interface{}{
+				"valuesFrom": []interface{}{
+					map[string]interface{}{"kind": "ConfigMap", "name": "my-values"},
+					map[string]interface{}{"kind": "Secret", "name": "forbidden-values", "targetPath": "password"},
+				},
+			},
+			expectedValuesResolved: `{"a":"configmap","b":{"c":"configmap"}}`,
+			expectedOrigins: []*corev1.ValueOrigin{
+				configMapOrigin("my-values", "values.yaml", "a"),
+				configMapOrigin("my-values", "values.yaml", "b.c"),
+				{
+					Path:     "password",
+					Source:   &corev1.ValueOrigin_SecretKeyRef{SecretKeyRef: &corev1.ValueSourceKeyRef{Name: "forbidden-values", Key: "values.yaml"}},
+					Redacted: true,
+				},
+			},
+			expectedPartial: true,
+		},
+		{
+			name: "skips references which are not found",
+			spec: map[string]interface{}{
+				"valuesFrom": []interface{}{
+					map[string]interface{}{"kind": "ConfigMap", "name": "missing-values", "optional": true},
+				},
+				"values": map[string]interface{}{"a": "inline"},
+			},
+			expectedValuesApplied:  `{"a":"inline"}`,
+			expectedValuesResolved: `{"a":"inline"}`,
+			expectedOrigins: []*corev1.ValueOrigin{
+				inlineOrigin("a"),
+			},
+		},
+		{
+			name: "reports the values as partial when a required reference is not found",
+			spec: map[string]interface{}{
+				"valuesFrom": []interface{}{
+					map[string]interface{}{"kind": "ConfigMap", "name": "missing-values"},
+				},
+				"values": map[string]interface{}{"a": "inline"},
+			},
+			expectedValuesApplied:  `{"a":"inline"}`,
+			expectedValuesResolved: `{"a":"inline"}`,
+			expectedOrigins: []*corev1.ValueOrigin{
+				inlineOrigin("a"),
+			},
+			expectedPartial: true,
+		},
+		{
+			name: "reports the values as partial when the key of a required reference is not found",
+			spec: map[string]interface{}{
+				"valuesFrom": []interface{}{
+					map[string]interface{}{"kind": "ConfigMap", "name": "my-values", "valuesKey": "missing.yaml"},
+				},
+				"values": map[string]interface{}{"a": "inline"},
+			},
+			expectedValuesApplied:  `{"a":"inline"}`,
+			expectedValuesResolved: `{"a":"inline"}`,
+			expectedOrigins: []*corev1.ValueOrigin{
+				inlineOrigin("a"),
+			},
+			expectedPartial: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mock, _, err := newServerWithRepos()
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+			dynamicClient, _, err := s.clientGetter(context.Background(), "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			dynamicClient.(*fake.FakeDynamicClient).PrependReactor("get", "secrets",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if action.(k8stesting.GetAction).GetName() == "forbidden-values" {
+						return true, nil, errors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "forbidden-values", fmt.Errorf("forbidden"))
+					}
+					return false, nil, nil
+				})
+			sources := []*unstructured.Unstructured{
+				{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]interface{}{"name": "my-values", "namespace": "test"},
+					"data": map[string]interface{}{
+						"values.yaml": "a: configmap\nb:\n  c: configmap\n",
+						"tag":         "v1",
+					},
+				}},
+				{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"metadata":   map[string]interface{}{"name": "my-secret-values", "namespace": "test"},
+					"data": map[string]interface{}{
+						"secret.yaml": base64.StdEncoding.EncodeToString([]byte("b:\n  c: secret\n")),
+					},
+				}},
+			}
+			for _, source := range sources {
+				resource := schema.GroupVersionResource{Version: "v1", Resource: strings.ToLower(source.GetKind()) + "s"}
+				if _, err = dynamicClient.Resource(resource).Namespace("test").Create(context.Background(), source, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			unstructuredRelease := map[string]interface{}{"spec": tc.spec}
+			name := types.NamespacedName{Namespace: "test", Name: "my-podinfo"}
+			valuesResolved, origins, partial, err := s.valuesResolvedFromUnstructured(context.Background(), "", name, unstructuredRelease)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := partial, tc.expectedPartial; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+
+			if got, want := valuesResolved, tc.expectedValuesResolved; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+			// the values applied, which an update replaces, are only the
+			// inline ones so that the values of secrets are not written back
+			valuesApplied, err := valuesAppliedFromUnstructured(name, unstructuredRelease)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := valuesApplied, tc.expectedValuesApplied; got != want {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+			opt1 := cmpopts.IgnoreUnexported(corev1.ValueOrigin{}, corev1.ValueOrigin_Inline{}, corev1.ValueOrigin_ConfigMapKeyRef{}, corev1.ValueOrigin_SecretKeyRef{}, corev1.ValueSourceKeyRef{})
+			if got, want := origins, tc.expectedOrigins; !cmp.Equal(want, got, opt1) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
 func TestCreateInstalledPackage(t *testing.T) {
 	testCases := []struct {
 		name               string
@@ -609,6 +835,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 		existingObjs       testSpecCreateInstalledPackage
 		expectedStatusCode codes.Code
 		expectedResponse   *corev1.CreateInstalledPackageResponse
+		expectedSpec       map[string]interface{}
 	}{
 		{
 			name: "create simple package",
@@ -640,6 +867,136 @@ func TestCreateInstalledPackage(t *testing.T) {
 					Identifier: "my-podinfo",
 				},
 			},
+			expectedSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart": "podinfo",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"install": map[string]interface{}{
+					"createNamespace": true,
+				},
+				"interval":        "1m",
+				"targetNamespace": "test",
+			},
+		},
+		{
+			name: "create package with version, values and reconciliation options",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "podinfo/podinfo",
+					Context: &corev1.Context{
+						Namespace: "namespace-1",
+					},
+				},
+				Name: "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "> 5",
+				},
+				Values: "{\"ui\": { \"message\": \"what we do in the shadows\" } }",
+				ReconciliationOptions: &corev1.ReconciliationOptions{
+					Interval:           60,
+					Suspend:            true,
+					ServiceAccountName: "foo",
+				},
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+				chartName:     "podinfo",
+				chartTarGz:    "testdata/podinfo-6.0.0.tgz",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.CreateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart":   "podinfo",
+						"version": "> 5",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"install": map[string]interface{}{
+					"createNamespace": true,
+				},
+				"interval":           "1m0s",
+				"serviceAccountName": "foo",
+				"suspend":            true,
+				"targetNamespace":    "test",
+				"values": map[string]interface{}{
+					"ui": map[string]interface{}{
+						"message": "what we do in the shadows",
+					},
+				},
+			},
+		},
+		{
+			name: "returns invalid argument for values which cannot be parsed",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "podinfo/podinfo",
+					Context: &corev1.Context{
+						Namespace: "namespace-1",
+					},
+				},
+				Name: "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+				Values: "not: [valid",
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+				chartName:     "podinfo",
+				chartTarGz:    "testdata/podinfo-6.0.0.tgz",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument for an available package on another cluster",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "podinfo/podinfo",
+					Context: &corev1.Context{
+						Cluster:   "other",
+						Namespace: "namespace-1",
+					},
+				},
+				Name: "my-podinfo",
+				TargetContext: &corev1.Context{
+					Namespace: "test",
+				},
+			},
+			existingObjs: testSpecCreateInstalledPackage{
+				repoName:      "podinfo",
+				repoNamespace: "namespace-1",
+				repoIndex:     "testdata/podinfo-index.yaml",
+				chartName:     "podinfo",
+				chartTarGz:    "testdata/podinfo-6.0.0.tgz",
+			},
+			expectedStatusCode: codes.InvalidArgument,
 		},
 	}
 
@@ -676,6 +1033,7 @@ func TestCreateInstalledPackage(t *testing.T) {
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
+			s.clustersConfig.Clusters["other"] = kubeutils.ClusterConfig{Name: "other"}
 
 			redisKey, bytes, err := redisKeyValueForRuntimeObject(repo)
 			if err != nil {
@@ -704,6 +1062,27 @@ func TestCreateInstalledPackage(t *testing.T) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
 			}
 
+			ifc, err := s.getReleasesResourceInterface(context.Background(), "", "kubeapps")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			created, err := ifc.Get(context.Background(), "my-podinfo", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := created.Object["spec"], tc.expectedSpec; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			// the reconciliation options read back from the release should be
+			// those requested
+			if tc.request.ReconciliationOptions != nil {
+				reconcileOpts := cmpopts.IgnoreUnexported(corev1.ReconciliationOptions{})
+				if got, want := installedPackageReconciliationOptionsFromUnstructured(created.Object), tc.request.ReconciliationOptions; !cmp.Equal(want, got, reconcileOpts) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, reconcileOpts))
+				}
+			}
+
 			// we make sure that all expectations were met
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("there were unfulfilled expectations: %s", err)
@@ -712,6 +1091,508 @@ func TestCreateInstalledPackage(t *testing.T) {
 	}
 }
 
+func TestUpdateInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.UpdateInstalledPackageRequest
+		existingSpec       map[string]interface{}
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.UpdateInstalledPackageResponse
+		expectedSpec       map[string]interface{}
+	}{
+		{
+			name: "update version, values and reconciliation options",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: ">5",
+				},
+				Values: "{\"ui\": { \"message\": \"what we do in the shadows\" } }",
+				ReconciliationOptions: &corev1.ReconciliationOptions{
+					Interval:           300,
+					ServiceAccountName: "foo",
+				},
+			},
+			existingSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart":   "podinfo",
+						"version": "5.2.1",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"interval":        "1m",
+				"targetNamespace": "test",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+					Plugin:     fluxPlugin,
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart":   "podinfo",
+						"version": ">5",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"interval":           "5m0s",
+				"serviceAccountName": "foo",
+				"suspend":            false,
+				"targetNamespace":    "test",
+				"values": map[string]interface{}{
+					"ui": map[string]interface{}{
+						"message": "what we do in the shadows",
+					},
+				},
+			},
+		},
+		{
+			name: "keeps the version and values when omitted from the request",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+				},
+				ReconciliationOptions: &corev1.ReconciliationOptions{
+					Interval: 300,
+				},
+			},
+			existingSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart":   "podinfo",
+						"version": "5.2.1",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"interval":        "1m",
+				"targetNamespace": "test",
+				"values": map[string]interface{}{
+					"replicaCount": int64(2),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+					Plugin:     fluxPlugin,
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"chart": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"chart":   "podinfo",
+						"version": "5.2.1",
+						"sourceRef": map[string]interface{}{
+							"kind":      "HelmRepository",
+							"name":      "podinfo",
+							"namespace": "namespace-1",
+						},
+					},
+				},
+				"interval":        "5m0s",
+				"suspend":         false,
+				"targetNamespace": "test",
+				"values": map[string]interface{}{
+					"replicaCount": int64(2),
+				},
+			},
+		},
+		{
+			name: "returns not found for a missing release",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			existingSpec:       map[string]interface{}{},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument for a missing namespace",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-podinfo",
+				},
+			},
+			existingSpec:       map[string]interface{}{},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := newRelease("my-podinfo", "kubeapps", tc.existingSpec, nil)
+			s, mock, _, err := newServerWithChartsAndReleases(nil, rel)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			response, err := s.UpdateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(
+				corev1.UpdateInstalledPackageResponse{},
+				corev1.InstalledPackageReference{},
+				corev1.Context{},
+				plugins.Plugin{})
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			ifc, err := s.getReleasesResourceInterface(context.Background(), "", "kubeapps")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			updated, err := ifc.Get(context.Background(), "my-podinfo", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := updated.Object["spec"], tc.expectedSpec; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			// we make sure that all expectations were met
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestDeleteInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.DeleteInstalledPackageRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "deletes the release",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "my-podinfo",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns not found for a missing release",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "kubeapps",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument for a missing namespace",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-podinfo",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := newRelease("my-podinfo", "kubeapps", map[string]interface{}{}, nil)
+			s, mock, _, err := newServerWithChartsAndReleases(nil, rel)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			_, err = s.DeleteInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			ifc, err := s.getReleasesResourceInterface(context.Background(), "", "kubeapps")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			_, err = ifc.Get(context.Background(), "my-podinfo", metav1.GetOptions{})
+			if !errors.IsNotFound(err) {
+				t.Errorf("got: %+v, want: not found error", err)
+			}
+
+			// we make sure that all expectations were met
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestWatchInstalledPackages(t *testing.T) {
+	s, _, _, err := newServerWithChartsAndReleases(nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// Control the events of the watch on the releases.
+	releaseWatcher := watch.NewFake()
+	dynamicClient, _, err := s.clientGetter(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	dynamicClient.(*fake.FakeDynamicClient).PrependWatchReactor(
+		fluxHelmReleases,
+		k8stesting.DefaultWatchReactor(releaseWatcher, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, responses: make(chan *corev1.WatchInstalledPackagesResponse, 10)}
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- s.WatchInstalledPackages(&corev1.WatchInstalledPackagesRequest{
+			Context: &corev1.Context{Namespace: "test"},
+		}, stream)
+	}()
+
+	readyStatus := map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":   "Ready",
+				"status": "True",
+				"reason": "ReconciliationSucceeded",
+			},
+		},
+	}
+	releaseWatcher.Add(newRelease("my-redis", "test", nil, map[string]interface{}{}))
+	releaseWatcher.Modify(newRelease("my-redis", "test", nil, map[string]interface{}{}))
+	releaseWatcher.Modify(newRelease("my-redis", "test", nil, readyStatus))
+	releaseWatcher.Delete(newRelease("my-redis", "test", nil, readyStatus))
+
+	ref := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Namespace: "test"},
+		Identifier: "my-redis",
+	}
+	installedStatus := &corev1.InstalledPackageStatus{
+		Ready:      true,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		UserReason: "ReconciliationSucceeded",
+	}
+	expectedResponses := []*corev1.WatchInstalledPackagesResponse{
+		{InstalledPackageRef: ref, Status: &corev1.InstalledPackageStatus{Reason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING}},
+		{InstalledPackageRef: ref, Status: installedStatus},
+		{InstalledPackageRef: ref, Status: installedStatus, Deleted: true},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackagesResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.InstalledPackageStatus{},
+	)
+	for _, want := range expectedResponses {
+		select {
+		case got := <-stream.responses:
+			if !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %+v", want)
+		}
+	}
+
+	cancel()
+	if err := <-watchErr; err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+// fakeWatchStream collects the responses sent on a WatchInstalledPackages stream.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackagesResponse
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(response *corev1.WatchInstalledPackagesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestGetInstalledPackageResources(t *testing.T) {
+	podinfoStub := helmReleaseStub{
+		name:         "test-my-podinfo",
+		namespace:    "test",
+		chartVersion: "6.0.0",
+		status:       release.StatusDeployed,
+		manifest: `
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-podinfo
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-podinfo
+`,
+	}
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":      "my-podinfo",
+				"namespace": "test",
+			},
+		},
+	}
+	serviceRef := &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-podinfo", Namespace: "test"}
+	deploymentRef := &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-podinfo", Namespace: "test"}
+
+	testCases := []struct {
+		name               string
+		installedRef       *corev1.InstalledPackageReference
+		expectedStatusCode codes.Code
+		expectedRefs       []*corev1.ResourceRef
+		expectedStatuses   []*corev1.ResourceStatus
+	}{
+		{
+			name: "returns the resources of the helm release",
+			installedRef: &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Namespace: "kubeapps",
+				},
+				Identifier: "my-podinfo",
+			},
+			expectedStatusCode: codes.OK,
+			expectedRefs:       []*corev1.ResourceRef{serviceRef, deploymentRef},
+			expectedStatuses: []*corev1.ResourceStatus{
+				{ResourceRef: serviceRef, Ready: true},
+				{ResourceRef: deploymentRef, Reason: "Not found"},
+			},
+		},
+		{
+			name: "returns not found for a missing release",
+			installedRef: &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Namespace: "kubeapps",
+				},
+				Identifier: "not-a-valid-identifier",
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument for a missing namespace",
+			installedRef: &corev1.InstalledPackageReference{
+				Identifier: "my-podinfo",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument for a cluster which is not configured",
+			installedRef: &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Cluster:   "unknown",
+					Namespace: "kubeapps",
+				},
+				Identifier: "my-podinfo",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := newRelease("my-podinfo", "kubeapps", map[string]interface{}{"targetNamespace": "test"}, nil)
+			actionConfig := newHelmActionConfig(t, "test", []helmReleaseStub{podinfoStub})
+			s, _, _, err := newServerWithChartsAndReleases(actionConfig, rel, service)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			refsResponse, err := s.GetInstalledPackageResourceRefs(context.Background(), &corev1.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: tc.installedRef,
+			})
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			statusesResponse, err := s.GetInstalledPackageResourceStatuses(context.Background(), &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: tc.installedRef,
+			})
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.ResourceRef{}, corev1.ResourceStatus{})
+			if got, want := refsResponse.ResourceRefs, tc.expectedRefs; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+			if got, want := statusesResponse.ResourceStatuses, tc.expectedStatuses; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
 func newRelease(name string, namespace string, spec map[string]interface{}, status map[string]interface{}) *unstructured.Unstructured {
 	metadata := map[string]interface{}{
 		"name":            name,
@@ -753,8 +1634,19 @@ func newServerWithChartsAndReleases(actionConfig *action.Configuration, chartOrR
 		chartOrRelease...)
 
 	apiextIfc := apiextfake.NewSimpleClientset(fluxHelmRepositoryCRD)
+	// the resources of the released manifests, for the discovery of their kinds
+	apiextIfc.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "services", Kind: "Service", Namespaced: true}},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+		},
+	}
 
-	clientGetter := func(context.Context) (dynamic.Interface, apiext.Interface, error) {
+	clientGetter := func(context.Context, string) (dynamic.Interface, apiext.Interface, error) {
 		return dynamicClient, apiextIfc, nil
 	}
 
@@ -815,7 +1707,8 @@ func newHelmActionConfig(t *testing.T, namespace string, rels []helmReleaseStub)
 					AppVersion: "1.2.3",
 				},
 			},
-			Config: config,
+			Config:   config,
+			Manifest: r.manifest,
 		}
 		err := actionConfig.Releases.Create(rel)
 		if err != nil {
@@ -14,8 +14,13 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
@@ -24,8 +29,12 @@ import (
 	httpclient "github.com/kubeapps/kubeapps/pkg/http-client"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
@@ -40,10 +49,18 @@ const (
 	fluxHelmRepository     = "HelmRepository"
 	fluxHelmRepositories   = "helmrepositories"
 	fluxHelmRepositoryList = "HelmRepositoryList"
+
+	// the interval at which flux checks a HelmRepository for updates, when
+	// none is specified
+	defaultRepoInterval = "10m"
+
+	// the time allowed to fetch the index of a repository when it is created
+	// or updated, well below the default timeout of the http client
+	repoIndexCheckTimeout = 10 * time.Second
 )
 
-func (s *Server) getRepoResourceInterface(ctx context.Context, namespace string) (dynamic.ResourceInterface, error) {
-	client, err := s.getDynamicClient(ctx)
+func (s *Server) getRepoResourceInterface(ctx context.Context, cluster string, namespace string) (dynamic.ResourceInterface, error) {
+	client, err := s.getDynamicClient(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +75,8 @@ func (s *Server) getRepoResourceInterface(ctx context.Context, namespace string)
 }
 
 // namespace maybe "", in which case repositories from all namespaces are returned
-func (s *Server) listReposInCluster(ctx context.Context, namespace string) (*unstructured.UnstructuredList, error) {
-	resourceIfc, err := s.getRepoResourceInterface(ctx, namespace)
+func (s *Server) listReposInCluster(ctx context.Context, cluster string, namespace string) (*unstructured.UnstructuredList, error) {
+	resourceIfc, err := s.getRepoResourceInterface(ctx, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +92,8 @@ func (s *Server) listReposInCluster(ctx context.Context, namespace string) (*uns
 	}
 }
 
-func (s *Server) getRepoInCluster(ctx context.Context, name types.NamespacedName) (*unstructured.Unstructured, error) {
-	resourceIfc, err := s.getRepoResourceInterface(ctx, name.Namespace)
+func (s *Server) getRepoInCluster(ctx context.Context, cluster string, name types.NamespacedName) (*unstructured.Unstructured, error) {
+	resourceIfc, err := s.getRepoResourceInterface(ctx, cluster, name.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -84,17 +101,18 @@ func (s *Server) getRepoInCluster(ctx context.Context, name types.NamespacedName
 	return resourceIfc.Get(ctx, name.Name, metav1.GetOptions{})
 }
 
-func (s *Server) repoExistsInCache(name types.NamespacedName) (bool, error) {
-	if s.cache == nil {
-		return false, status.Errorf(codes.FailedPrecondition, "server cache has not been properly initialized")
+func (s *Server) repoExistsInCache(cluster string, name types.NamespacedName) (bool, error) {
+	cache, err := s.getCache(cluster)
+	if err != nil {
+		return false, err
 	}
 
-	repo, err := s.cache.fetchForOne(s.cache.keyForNamespacedName(name))
+	repo, err := cache.fetchForOne(cache.keyForNamespacedName(name))
 	return repo != nil, err
 }
 
-func (s *Server) getRepoUrl(ctx context.Context, name types.NamespacedName) (string, error) {
-	repoUnstructured, err := s.getRepoInCluster(ctx, name)
+func (s *Server) getRepoUrl(ctx context.Context, cluster string, name types.NamespacedName) (string, error) {
+	repoUnstructured, err := s.getRepoInCluster(ctx, cluster, name)
 	if err != nil {
 		return "", status.Errorf(codes.NotFound, "Unable to find Helm repository %q due to %v", name, err)
 	} else if repoUnstructured == nil {
@@ -107,6 +125,238 @@ func (s *Server) getRepoUrl(ctx context.Context, name types.NamespacedName) (str
 	return repoUrl, nil
 }
 
+// createRepo creates a flux HelmRepository once its index has been found to be
+// reachable. The cache then picks up the new repository via its watcher.
+func (s *Server) createRepo(ctx context.Context, cluster string, name types.NamespacedName, url, interval, secretRef string) (*v1alpha1.PackageRepository, error) {
+	unstructuredRepo, err := newFluxHelmRepo(name, url, interval, secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// the repository url is fetched by kubeapps-apis itself, so it is only
+	// fetched on behalf of users who can create the repository.
+	if err = s.checkRepoAccess(ctx, cluster, "create", types.NamespacedName{Namespace: name.Namespace}); err != nil {
+		return nil, err
+	}
+	if err = s.checkRepoIndex(ctx, cluster, name.Namespace, url, secretRef); err != nil {
+		return nil, err
+	}
+
+	resourceIfc, err := s.getRepoResourceInterface(ctx, cluster, name.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	newRepo, err := resourceIfc.Create(ctx, unstructuredRepo, metav1.CreateOptions{})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "Helm repository %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm repository %q due to: %v", name, err)
+	}
+	return newPackageRepository(newRepo.Object)
+}
+
+// updateRepo replaces the url, interval and secret of an existing flux
+// HelmRepository, once its new index has been found to be reachable.
+func (s *Server) updateRepo(ctx context.Context, cluster string, name types.NamespacedName, url, interval, secretRef string) (*v1alpha1.PackageRepository, error) {
+	resourceIfc, err := s.getRepoResourceInterface(ctx, cluster, name.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	unstructuredRepo, err := resourceIfc.Get(ctx, name.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm repository %q due to: %v", name, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get Helm repository %q due to: %v", name, err)
+	}
+
+	if err = setRepoSpec(unstructuredRepo.Object, url, interval, secretRef); err != nil {
+		return nil, err
+	}
+
+	if err = s.checkRepoAccess(ctx, cluster, "update", name); err != nil {
+		return nil, err
+	}
+	if err = s.checkRepoIndex(ctx, cluster, name.Namespace, url, secretRef); err != nil {
+		return nil, err
+	}
+
+	updatedRepo, err := resourceIfc.Update(ctx, unstructuredRepo, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to update Helm repository %q due to: %v", name, err)
+	}
+	return newPackageRepository(updatedRepo.Object)
+}
+
+// deleteRepo deletes a flux HelmRepository. The cache then drops the packages
+// of the repository via its watcher.
+func (s *Server) deleteRepo(ctx context.Context, cluster string, name types.NamespacedName) error {
+	resourceIfc, err := s.getRepoResourceInterface(ctx, cluster, name.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if err = resourceIfc.Delete(ctx, name.Name, metav1.DeleteOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "Unable to find Helm repository %q due to: %v", name, err)
+		}
+		return status.Errorf(codes.Internal, "Unable to delete Helm repository %q due to: %v", name, err)
+	}
+	return nil
+}
+
+// checkRepoAccess checks that the user can perform the verb on the flux
+// HelmRepository with the given name, or on any HelmRepository in its namespace
+// when the name is empty, as is the case for creations.
+func (s *Server) checkRepoAccess(ctx context.Context, cluster, verb string, name types.NamespacedName) error {
+	client, err := s.getDynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	review, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&authorizationv1.SelfSubjectAccessReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "authorization.k8s.io/v1", Kind: "SelfSubjectAccessReview"},
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     fluxGroup,
+				Resource:  fluxHelmRepositories,
+				Verb:      verb,
+				Namespace: name.Namespace,
+				Name:      name.Name,
+			},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to create the access review: %v", err)
+	}
+	reviewsResource := schema.GroupVersionResource{
+		Group:    "authorization.k8s.io",
+		Version:  "v1",
+		Resource: "selfsubjectaccessreviews",
+	}
+	result, err := client.Resource(reviewsResource).Create(ctx, &unstructured.Unstructured{Object: review}, metav1.CreateOptions{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to check the access to the Helm repositories in namespace %q due to: %v", name.Namespace, err)
+	}
+	if allowed, _, _ := unstructured.NestedBool(result.Object, "status", "allowed"); !allowed {
+		return status.Errorf(codes.PermissionDenied, "The user is not allowed to %s Helm repositories in namespace %q", verb, name.Namespace)
+	}
+	return nil
+}
+
+// checkRepoIndex checks that the index.yaml of the repository at the given url
+// can be fetched, using the credentials in the secret of the repository, if any,
+// so that repositories which flux cannot index are not committed. The details
+// of a failure are only logged, so that the responses of arbitrary urls are
+// not returned to the user, and the request is neither allowed to take long
+// nor to be redirected to another host.
+func (s *Server) checkRepoIndex(ctx context.Context, cluster, namespace, repoUrl, secretRef string) error {
+	indexUrl, err := url.ParseRequestURI(strings.TrimSuffix(strings.TrimSpace(repoUrl), "/"))
+	if err != nil || (indexUrl.Scheme != "http" && indexUrl.Scheme != "https") {
+		return status.Errorf(codes.InvalidArgument, "Invalid repository url %q", repoUrl)
+	}
+	indexUrl.Path = path.Join(indexUrl.Path, "index.yaml")
+
+	reqCtx, cancel := context.WithTimeout(ctx, repoIndexCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, "GET", indexUrl.String(), nil)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid repository url %q: %v", repoUrl, err)
+	}
+	client := httpclient.New()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if req.URL.Host != indexUrl.Host {
+			return fmt.Errorf("redirect to another host %q", req.URL.Host)
+		}
+		if len(via) >= 10 {
+			return fmt.Errorf("stopped after 10 redirects")
+		}
+		return nil
+	}
+
+	if secretRef != "" {
+		secret, err := s.getRepoSecret(ctx, cluster, namespace, secretRef)
+		if err != nil {
+			return err
+		}
+		if err = setRepoCredentials(client, req, secret); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid credentials in secret %q: %v", secretRef, err)
+		}
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		log.Infof("Unable to fetch the index of repository [%s] due to: %v", repoUrl, err)
+		return status.Errorf(codes.InvalidArgument, "Unable to fetch the index of repository %q", repoUrl)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		log.Infof("Unable to fetch the index of repository [%s]: got status [%d]", repoUrl, res.StatusCode)
+		return status.Errorf(codes.InvalidArgument, "Unable to fetch the index of repository %q", repoUrl)
+	}
+	return nil
+}
+
+// getRepoSecret returns the secret with the credentials of a repository, which
+// flux requires to be in the namespace of the repository.
+func (s *Server) getRepoSecret(ctx context.Context, cluster, namespace, name string) (*apiv1.Secret, error) {
+	client, err := s.getDynamicClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	secretsResource := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "secrets",
+	}
+	unstructuredSecret, err := client.Resource(secretsResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find secret %q in namespace %q due to: %v", name, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get secret %q in namespace %q due to: %v", name, namespace, err)
+	}
+
+	var secret apiv1.Secret
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredSecret.Object, &secret); err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to convert secret %q due to: %v", name, err)
+	}
+	return &secret, nil
+}
+
+// setRepoCredentials configures the request and client with the credentials
+// of a flux HelmRepository secret, see
+// https://fluxcd.io/docs/components/source/helmrepositories/#spec-examples
+func setRepoCredentials(client *http.Client, req *http.Request, secret *apiv1.Secret) error {
+	username, password := string(secret.Data["username"]), string(secret.Data["password"])
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	certFile, keyFile, caFile := secret.Data["certFile"], secret.Data["keyFile"], secret.Data["caFile"]
+	if len(certFile) == 0 && len(keyFile) == 0 && len(caFile) == 0 {
+		return nil
+	}
+	caCertPool, err := httpclient.GetCertPool(caFile)
+	if err != nil {
+		return err
+	}
+	if err = httpclient.SetClientTLS(client, caCertPool, false); err != nil {
+		return err
+	}
+	if len(certFile) != 0 || len(keyFile) != 0 {
+		cert, err := tls.X509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		client.Transport.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	return nil
+}
+
 //
 // repo-related utilities
 //
@@ -207,6 +457,52 @@ func newPackageRepository(unstructuredRepo map[string]interface{}) (*v1alpha1.Pa
 	}, nil
 }
 
+func newFluxHelmRepo(name types.NamespacedName, url, interval, secretRef string) (*unstructured.Unstructured, error) {
+	unstructuredRepo := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": fmt.Sprintf("%s/%s", fluxGroup, fluxVersion),
+			"kind":       fluxHelmRepository,
+			"metadata": map[string]interface{}{
+				"name":      name.Name,
+				"namespace": name.Namespace,
+			},
+		},
+	}
+	if err := setRepoSpec(unstructuredRepo.Object, url, interval, secretRef); err != nil {
+		return nil, err
+	}
+	return &unstructuredRepo, nil
+}
+
+// setRepoSpec sets the fields of the HelmRepository spec which can be managed
+// through the API, removing the secretRef when no secret is given.
+func setRepoSpec(unstructuredRepo map[string]interface{}, url, interval, secretRef string) error {
+	if url == "" {
+		return status.Errorf(codes.InvalidArgument, "no request Url provided")
+	}
+	if interval == "" {
+		interval = defaultRepoInterval
+	} else if _, err := time.ParseDuration(interval); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid interval %q: %v", interval, err)
+	}
+
+	if err := unstructured.SetNestedField(unstructuredRepo, url, "spec", "url"); err != nil {
+		return status.Errorf(codes.Internal, "Unable to set the url of the Helm repository due to: %v", err)
+	}
+	if err := unstructured.SetNestedField(unstructuredRepo, interval, "spec", "interval"); err != nil {
+		return status.Errorf(codes.Internal, "Unable to set the interval of the Helm repository due to: %v", err)
+	}
+	if secretRef != "" {
+		err := unstructured.SetNestedField(unstructuredRepo, secretRef, "spec", "secretRef", "name")
+		if err != nil {
+			return status.Errorf(codes.Internal, "Unable to set the secret of the Helm repository due to: %v", err)
+		}
+	} else {
+		unstructured.RemoveNestedField(unstructuredRepo, "spec", "secretRef")
+	}
+	return nil
+}
+
 //
 // implements plug-in specific cache-related functionality
 //
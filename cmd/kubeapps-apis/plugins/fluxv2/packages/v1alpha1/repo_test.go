@@ -30,9 +30,11 @@ import (
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -553,7 +555,7 @@ func TestGetAvailablePackageSummaryAfterRepoIndexUpdate(t *testing.T) {
 		updateHappened = true
 		// now we are going to simulate flux seeing an update of the index.yaml and modifying the
 		// HelmRepository CRD which, in turn, causes k8s server to fire a MODIFY event
-		s.cache.eventProcessedWaitGroup.Add(1)
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Add(1)
 
 		key, bytes, err := redisKeyValueForRuntimeObject(repo)
 		if err != nil {
@@ -564,13 +566,13 @@ func TestGetAvailablePackageSummaryAfterRepoIndexUpdate(t *testing.T) {
 		unstructured.SetNestedField(repo.Object, "2", "metadata", "resourceVersion")
 		watcher.Modify(repo)
 
-		s.cache.eventProcessedWaitGroup.Wait()
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Wait()
 
 		if err = mock.ExpectationsWereMet(); err != nil {
 			t.Fatalf("%v", err)
 		}
 
-		mock.ExpectScan(0, "", 0).SetVal([]string{key}, 0)
+		mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{key}, 0)
 		mock.ExpectGet(key).SetVal(string(bytes))
 
 		responsePackagesAfterUpdate, err := s.GetAvailablePackageSummaries(
@@ -625,19 +627,19 @@ func TestGetAvailablePackageSummaryAfterFluxHelmRepoDelete(t *testing.T) {
 
 		// now we are going to simulate the user deleting a HelmRepository CRD which, in turn,
 		// causes k8s server to fire a DELETE event
-		s.cache.eventProcessedWaitGroup.Add(1)
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Add(1)
 		key := redisKeyForRuntimeObject(repo)
 		mock.ExpectDel(key).SetVal(0)
 
 		watcher.Delete(repo)
 
-		s.cache.eventProcessedWaitGroup.Wait()
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Wait()
 
 		if err = mock.ExpectationsWereMet(); err != nil {
 			t.Fatalf("%v", err)
 		}
 
-		mock.ExpectScan(0, "", 0).SetVal([]string{}, 0)
+		mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{}, 0)
 
 		responseAfterDelete, err := s.GetAvailablePackageSummaries(
 			context.Background(),
@@ -693,13 +695,16 @@ func TestGetAvailablePackageSummaryAfterCacheResync(t *testing.T) {
 
 		// now lets try to simulate HTTP 410 GONE exception which should force RetryWatcher to stop and force
 		// a cache resync
-		s.cache.eventProcessedWaitGroup.Add(1)
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Add(1)
 		key, bytes, _ := redisKeyValueForRuntimeObject(repo)
+		// the resync clears the keys of the cluster before populating them again
+		mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{key}, 0)
+		mock.ExpectDel(key).SetVal(1)
 		mock.ExpectSet(key, bytes, 0).SetVal("")
 
 		watcher.Error(&errors.NewGone("test HTTP 410 Gone").ErrStatus)
 
-		s.cache.eventProcessedWaitGroup.Wait()
+		s.caches[kubeappsCluster].eventProcessedWaitGroup.Wait()
 
 		if err = mock.ExpectationsWereMet(); err != nil {
 			t.Fatalf("%v", err)
@@ -852,6 +857,373 @@ func TestGetPackageRepositories(t *testing.T) {
 	}
 }
 
+func TestCreatePackageRepository(t *testing.T) {
+	// the index of the repository is only served with the expected credentials
+	indexRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		indexRequests++
+		if username, password, ok := r.BasicAuth(); ok && (username != "foo" || password != "bar") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/charts/index.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, "apiVersion: v1")
+	}))
+	defer ts.Close()
+
+	// a repository which redirects the requests for its index to another host
+	redirectingTs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, ts.URL+"/charts/index.yaml", http.StatusFound)
+	}))
+	defer redirectingTs.Close()
+
+	testCases := []struct {
+		name               string
+		request            *v1alpha1.CreatePackageRepositoryRequest
+		accessDenied       bool
+		expectedStatusCode codes.Code
+		expectedSpec       map[string]interface{}
+	}{
+		{
+			name: "creates a repository with the default interval",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "my-repo",
+				Url:     ts.URL + "/charts/",
+			},
+			expectedStatusCode: codes.OK,
+			expectedSpec: map[string]interface{}{
+				"url":      ts.URL + "/charts/",
+				"interval": "10m",
+			},
+		},
+		{
+			name: "creates a repository with an interval and a secret",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context:   &corev1.Context{Namespace: "default"},
+				Name:      "my-repo",
+				Url:       ts.URL + "/charts",
+				Interval:  "1m0s",
+				SecretRef: "my-secret",
+			},
+			expectedStatusCode: codes.OK,
+			expectedSpec: map[string]interface{}{
+				"url":      ts.URL + "/charts",
+				"interval": "1m0s",
+				"secretRef": map[string]interface{}{
+					"name": "my-secret",
+				},
+			},
+		},
+		{
+			name: "returns invalid argument when the index is not reachable",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "my-repo",
+				Url:     ts.URL + "/other",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument when the index is redirected to another host",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "my-repo",
+				Url:     redirectingTs.URL + "/charts",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument when the credentials are wrong",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context:   &corev1.Context{Namespace: "default"},
+				Name:      "my-repo",
+				Url:       ts.URL + "/charts",
+				SecretRef: "wrong-secret",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns not found when the secret does not exist",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context:   &corev1.Context{Namespace: "default"},
+				Name:      "my-repo",
+				Url:       ts.URL + "/charts",
+				SecretRef: "missing-secret",
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument for an invalid interval",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context:  &corev1.Context{Namespace: "default"},
+				Name:     "my-repo",
+				Url:      ts.URL + "/charts",
+				Interval: "often",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument without a url",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "my-repo",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid argument without a namespace",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Name: "my-repo",
+				Url:  ts.URL + "/charts",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns already exists for an existing repository",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "existing-repo",
+				Url:     ts.URL + "/charts",
+			},
+			expectedStatusCode: codes.AlreadyExists,
+		},
+		{
+			name: "returns permission denied without fetching the index when the user cannot create repositories",
+			request: &v1alpha1.CreatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "my-repo",
+				Url:     ts.URL + "/charts",
+			},
+			accessDenied:       true,
+			expectedStatusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mock, _, err := newServerWithRepos(newRepo("existing-repo", "default", nil, nil))
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+			addRepoSecrets(t, s, "default")
+			if tc.accessDenied {
+				denyRepoAccess(t, s)
+			}
+			indexRequests = 0
+
+			response, err := s.CreatePackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.accessDenied && indexRequests != 0 {
+				t.Errorf("got: %d requests for the index, want: none", indexRequests)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			expectedRepo := &v1alpha1.PackageRepository{
+				Name:      tc.request.Name,
+				Namespace: tc.request.Context.Namespace,
+				Url:       tc.request.Url,
+			}
+			opt1 := cmpopts.IgnoreUnexported(v1alpha1.PackageRepository{})
+			if got, want := response.Repository, expectedRepo; !cmp.Equal(want, got, opt1) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
+			}
+
+			repo := getRepoFromCluster(t, s, types.NamespacedName{Namespace: "default", Name: tc.request.Name})
+			if got, want := repo.Object["spec"], tc.expectedSpec; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
+func TestUpdatePackageRepository(t *testing.T) {
+	indexRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		indexRequests++
+		if r.URL.Path != "/charts/index.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, "apiVersion: v1")
+	}))
+	defer ts.Close()
+
+	testCases := []struct {
+		name               string
+		request            *v1alpha1.UpdatePackageRepositoryRequest
+		accessDenied       bool
+		expectedStatusCode codes.Code
+		expectedSpec       map[string]interface{}
+	}{
+		{
+			name: "replaces the url, interval and secret of the repository",
+			request: &v1alpha1.UpdatePackageRepositoryRequest{
+				Context:  &corev1.Context{Namespace: "default"},
+				Name:     "existing-repo",
+				Url:      ts.URL + "/charts",
+				Interval: "5m",
+			},
+			expectedStatusCode: codes.OK,
+			expectedSpec: map[string]interface{}{
+				"url":      ts.URL + "/charts",
+				"interval": "5m",
+			},
+		},
+		{
+			name: "returns invalid argument when the index is not reachable",
+			request: &v1alpha1.UpdatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "existing-repo",
+				Url:     ts.URL + "/other",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns not found for a missing repository",
+			request: &v1alpha1.UpdatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "missing-repo",
+				Url:     ts.URL + "/charts",
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns permission denied without fetching the index when the user cannot update the repository",
+			request: &v1alpha1.UpdatePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "existing-repo",
+				Url:     ts.URL + "/charts",
+			},
+			accessDenied:       true,
+			expectedStatusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			existingRepo := newRepo("existing-repo", "default",
+				map[string]interface{}{
+					"url":      "https://example.repo.com/charts",
+					"interval": "1m0s",
+					"secretRef": map[string]interface{}{
+						"name": "my-secret",
+					},
+				}, nil)
+			s, mock, _, err := newServerWithRepos(existingRepo)
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+			if tc.accessDenied {
+				denyRepoAccess(t, s)
+			}
+			indexRequests = 0
+
+			response, err := s.UpdatePackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.accessDenied && indexRequests != 0 {
+				t.Errorf("got: %d requests for the index, want: none", indexRequests)
+			}
+
+			name := types.NamespacedName{Namespace: "default", Name: "existing-repo"}
+			if tc.expectedStatusCode != codes.OK {
+				// the repository is left untouched
+				repo := getRepoFromCluster(t, s, name)
+				if got, want := repo.Object["spec"], existingRepo.Object["spec"]; !cmp.Equal(want, got) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+				return
+			}
+
+			if got, want := response.Repository.Url, tc.request.Url; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			repo := getRepoFromCluster(t, s, name)
+			if got, want := repo.Object["spec"], tc.expectedSpec; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
+func TestDeletePackageRepository(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *v1alpha1.DeletePackageRepositoryRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "deletes the repository",
+			request: &v1alpha1.DeletePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "existing-repo",
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns not found for a missing repository",
+			request: &v1alpha1.DeletePackageRepositoryRequest{
+				Context: &corev1.Context{Namespace: "default"},
+				Name:    "missing-repo",
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mock, _, err := newServerWithRepos(newRepo("existing-repo", "default", nil, nil))
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+
+			_, err = s.DeletePackageRepository(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode == codes.OK {
+				dynamicClient, _, err := s.clientGetter(context.Background(), "")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				_, err = dynamicClient.Resource(schema.GroupVersionResource{
+					Group:    fluxGroup,
+					Version:  fluxVersion,
+					Resource: fluxHelmRepositories,
+				}).Namespace("default").Get(context.Background(), tc.request.Name, metav1.GetOptions{})
+				if !errors.IsNotFound(err) {
+					t.Errorf("got: %+v, want: not found", err)
+				}
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
 func newServerWithRepos(repos ...runtime.Object) (*Server, redismock.ClientMock, *watch.FakeWatcher, error) {
 	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
 		runtime.NewScheme(),
@@ -877,6 +1249,9 @@ func newServerWithRepos(repos ...runtime.Object) (*Server, redismock.ClientMock,
 			return handled, ret, err
 		})
 
+	// the user can manage the repositories unless a test denies it
+	dynamicClient.Fake.PrependReactor("create", "selfsubjectaccessreviews", accessReviewReactor(true))
+
 	watcher := watch.NewFake()
 
 	dynamicClient.Fake.PrependWatchReactor(
@@ -885,7 +1260,7 @@ func newServerWithRepos(repos ...runtime.Object) (*Server, redismock.ClientMock,
 
 	apiextIfc := apiextfake.NewSimpleClientset(fluxHelmRepositoryCRD)
 
-	clientGetter := func(context.Context) (dynamic.Interface, apiext.Interface, error) {
+	clientGetter := func(context.Context, string) (dynamic.Interface, apiext.Interface, error) {
 		return dynamicClient, apiextIfc, nil
 	}
 
@@ -944,7 +1319,7 @@ func beforeCallGetAvailablePackageSummaries(mock redismock.ClientMock, filterOpt
 		mapVals[key] = bytes
 	}
 	if filterOptions == nil || len(filterOptions.GetRepositories()) == 0 {
-		mock.ExpectScan(0, "", 0).SetVal(keys, 0)
+		mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal(keys, 0)
 		for _, k := range keys {
 			mock.ExpectGet(k).SetVal(string(mapVals[k]))
 		}
@@ -956,7 +1331,7 @@ func beforeCallGetAvailablePackageSummaries(mock redismock.ClientMock, filterOpt
 					keys = append(keys, k)
 				}
 			}
-			mock.ExpectScan(0, fluxHelmRepositories+":*:"+r, 0).SetVal(keys, 0)
+			mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*:"+r, 0).SetVal(keys, 0)
 			for _, k := range keys {
 				mock.ExpectGet(k).SetVal(string(mapVals[k]))
 			}
@@ -978,7 +1353,7 @@ func redisKeyForRuntimeObject(r runtime.Object) string {
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmrepository:ns:repoName"
+	// We will use "helmrepositories:cluster:ns:repoName"
 	return redisKeyForNamespacedName(types.NamespacedName{
 		Namespace: r.(*unstructured.Unstructured).GetNamespace(),
 		Name:      r.(*unstructured.Unstructured).GetName()})
@@ -988,8 +1363,8 @@ func redisKeyForNamespacedName(name types.NamespacedName) string {
 	// redis convention on key format
 	// https://redis.io/topics/data-types-intro
 	// Try to stick with a schema. For instance "object-type:id" is a good idea, as in "user:1000".
-	// We will use "helmrepository:ns:repoName"
-	return fmt.Sprintf("%s:%s:%s", fluxHelmRepositories, name.Namespace, name.Name)
+	// We will use "helmrepositories:cluster:ns:repoName"
+	return fmt.Sprintf("%s:%s:%s:%s", fluxHelmRepositories, kubeappsCluster, name.Namespace, name.Name)
 }
 
 func newRepoWithIndex(repoIndex, repoName, repoNamespace string) (*httptest.Server, *unstructured.Unstructured, error) {
@@ -1159,3 +1534,69 @@ var index_after_update_summaries = []*corev1.AvailablePackageSummary{
 			Plugin:     fluxPlugin,
 		},
 	}}
+
+// addRepoSecrets adds a secret with the expected credentials of the test
+// repositories and one with the wrong credentials to the cluster.
+// accessReviewReactor returns a reactor answering the SelfSubjectAccessReviews
+// with the given result.
+func accessReviewReactor(allowed bool) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured).DeepCopy()
+		if err := unstructured.SetNestedField(review.Object, allowed, "status", "allowed"); err != nil {
+			return true, nil, err
+		}
+		return true, review, nil
+	}
+}
+
+// denyRepoAccess makes the SelfSubjectAccessReviews on the kubeapps cluster
+// deny the access to the repositories.
+func denyRepoAccess(t *testing.T, s *Server) {
+	dynamicClient, _, err := s.clientGetter(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	dynamicClient.(*fake.FakeDynamicClient).Fake.PrependReactor("create", "selfsubjectaccessreviews", accessReviewReactor(false))
+}
+
+func addRepoSecrets(t *testing.T, s *Server, namespace string) {
+	dynamicClient, _, err := s.clientGetter(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	secrets := map[string]map[string][]byte{
+		"my-secret":    {"username": []byte("foo"), "password": []byte("bar")},
+		"wrong-secret": {"username": []byte("foo"), "password": []byte("zot")},
+	}
+	for name, data := range secrets {
+		secret, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&apiv1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       data,
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		_, err = dynamicClient.Resource(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}).
+			Namespace(namespace).Create(context.Background(), &unstructured.Unstructured{Object: secret}, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+}
+
+func getRepoFromCluster(t *testing.T, s *Server, name types.NamespacedName) *unstructured.Unstructured {
+	dynamicClient, _, err := s.clientGetter(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	repo, err := dynamicClient.Resource(schema.GroupVersionResource{
+		Group:    fluxGroup,
+		Version:  fluxVersion,
+		Resource: fluxHelmRepositories,
+	}).Namespace(name.Namespace).Get(context.Background(), name.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return repo
+}
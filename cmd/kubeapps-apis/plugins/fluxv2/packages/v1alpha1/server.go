@@ -15,30 +15,32 @@ package main
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/kube"
 	apiext "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/server"
 	"github.com/kubeapps/kubeapps/pkg/agent"
+	kubeutils "github.com/kubeapps/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	log "k8s.io/klog/v2"
 )
 
 // Compile-time statement to ensure this service implementation satisfies the core packaging API
-var _ corev1.PackagesServiceServer = (*Server)(nil)
+var _ server.PackagesPluginServer = (*Server)(nil)
 
-type clientGetter func(context.Context) (dynamic.Interface, apiext.Interface, error)
-type helmActionConfigGetter func(ctx context.Context, namespace string) (*action.Configuration, error)
+type clientGetter func(ctx context.Context, cluster string) (dynamic.Interface, apiext.Interface, error)
+type helmActionConfigGetter func(ctx context.Context, cluster string, namespace string) (*action.Configuration, error)
 
 // Server implements the fluxv2 packages v1alpha1 interface.
 type Server struct {
@@ -50,40 +52,33 @@ type Server struct {
 	clientGetter       clientGetter
 	actionConfigGetter helmActionConfigGetter
 
-	cache *NamespacedResourceWatcherCache
+	// kubeappsCluster is the cluster on which Kubeapps is installed, used
+	// when a request does not specify a cluster.
+	kubeappsCluster string
+	clustersConfig  kubeutils.ClustersConfig
+
+	// caches holds the cache of the flux HelmRepositories for each of the
+	// configured clusters, keyed by the cluster name.
+	caches map[string]*NamespacedResourceWatcherCache
+	// cacheErrors holds the reason why the cache of the flux HelmRepositories
+	// could not be started, for each of the clusters without a cache.
+	cacheErrors map[string]error
+	// artifactCaches holds the caches of the flux GitRepositories and Buckets
+	// for each of the configured clusters, keyed by the cluster name.
+	artifactCaches map[string][]*NamespacedResourceWatcherCache
 }
 
 // NewServer returns a Server automatically configured with a function to obtain
 // the k8s client config.
-func NewServer(configGetter server.KubernetesConfigGetter) (*Server, error) {
-	clientGetter := func(ctx context.Context) (dynamic.Interface, apiext.Interface, error) {
-		if configGetter == nil {
-			return nil, nil, status.Errorf(codes.Internal, "configGetter arg required")
-		}
-		// The Flux plugin currently supports interactions with the default (kubeapps)
-		// cluster only:
-		cluster := ""
-		config, err := configGetter(ctx, cluster)
-		if err != nil {
-			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get config : %v", err))
-		}
-		dynamicClient, err := dynamic.NewForConfig(config)
-		if err != nil {
-			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get dynamic client : %v", err))
-		}
-		apiExtensions, err := apiext.NewForConfig(config)
-		if err != nil {
-			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get api extensions client : %v", err))
-		}
-		return dynamicClient, apiExtensions, nil
-	}
-	actionConfigGetter := func(ctx context.Context, namespace string) (*action.Configuration, error) {
+func NewServer(configGetter server.KubernetesConfigGetter, clustersConfig kubeutils.ClustersConfig, storageForDriver agent.StorageForDriver) (*Server, error) {
+	clientGetter := newClientGetter(configGetter)
+	// the caches watch the flux sources outside of any request, so without
+	// the token of a user.
+	backgroundClientGetter := newClientGetter(newBackgroundConfigGetter(configGetter, clustersConfig))
+	actionConfigGetter := func(ctx context.Context, cluster string, namespace string) (*action.Configuration, error) {
 		if configGetter == nil {
 			return nil, status.Errorf(codes.Internal, "configGetter arg required")
 		}
-		// The Flux plugin currently supports interactions with the default (kubeapps)
-		// cluster only:
-		cluster := ""
 		config, err := configGetter(ctx, cluster)
 		if err != nil {
 			return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get config : %v", err))
@@ -94,8 +89,10 @@ func NewServer(configGetter server.KubernetesConfigGetter) (*Server, error) {
 		if err != nil {
 			return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to create kubernetes client : %v", err))
 		}
-		// TODO(mnelson): Update to allow different helm storage options.
-		storage := agent.StorageForSecrets(namespace, clientSet)
+		storage, err := storageForDriver(namespace, clientSet)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the storage of the Helm releases : %v", err))
+		}
 		return &action.Configuration{
 			RESTClientGetter: restClientGetter,
 			KubeClient:       kube.New(restClientGetter),
@@ -108,37 +105,159 @@ func NewServer(configGetter server.KubernetesConfigGetter) (*Server, error) {
 		Version:  fluxVersion,
 		Resource: fluxHelmRepositories,
 	}
-	cacheConfig := cacheConfig{
-		gvr:          repositoriesGvr,
-		clientGetter: clientGetter,
-		onAdd:        onAddOrModifyRepo,
-		onModify:     onAddOrModifyRepo,
-		onGet:        onGetRepo,
-		onDelete:     onDeleteRepo,
+
+	// the kubeapps cluster is always watched, even when it is not listed
+	// explicitly in the clusters configuration.
+	clusters := []string{clustersConfig.KubeappsClusterName}
+	for cluster := range clustersConfig.Clusters {
+		if cluster != clustersConfig.KubeappsClusterName {
+			clusters = append(clusters, cluster)
+		}
 	}
-	cache, err := newCache(cacheConfig)
-	if err != nil {
-		return nil, err
+	caches := map[string]*NamespacedResourceWatcherCache{}
+	cacheErrors := map[string]error{}
+	artifactCaches := map[string][]*NamespacedResourceWatcherCache{}
+	for _, cluster := range clusters {
+		cacheConfig := cacheConfig{
+			gvr:          repositoriesGvr,
+			cluster:      cluster,
+			clientGetter: backgroundClientGetter,
+			onAdd:        onAddOrModifyRepo,
+			onModify:     onAddOrModifyRepo,
+			onGet:        onGetRepo,
+			onDelete:     onDeleteRepo,
+		}
+		cache, err := newCache(cacheConfig)
+		if err != nil {
+			if cluster == clustersConfig.KubeappsClusterName {
+				return nil, err
+			}
+			// flux may well not be installed on some of the additional clusters,
+			// which should not prevent the plugin from serving the others. The
+			// reason is returned with the requests for the cluster.
+			log.Errorf("Unable to create the cache of the fluxv2 helmrepositories on cluster [%s] due to: %v", cluster, err)
+			cacheErrors[cluster] = err
+			continue
+		}
+		caches[cluster] = cache
+		artifactCaches[cluster] = newArtifactCaches(cluster, backgroundClientGetter)
 	}
 	return &Server{
 		clientGetter:       clientGetter,
 		actionConfigGetter: actionConfigGetter,
-		cache:              cache,
+		kubeappsCluster:    clustersConfig.KubeappsClusterName,
+		clustersConfig:     clustersConfig,
+		caches:             caches,
+		cacheErrors:        cacheErrors,
+		artifactCaches:     artifactCaches,
 	}, nil
 }
 
-// getDynamicClient returns a dynamic k8s client.
-func (s *Server) getDynamicClient(ctx context.Context) (dynamic.Interface, error) {
+// newClientGetter returns a clientGetter creating the clients with the config
+// returned by the given configGetter.
+func newClientGetter(configGetter server.KubernetesConfigGetter) clientGetter {
+	return func(ctx context.Context, cluster string) (dynamic.Interface, apiext.Interface, error) {
+		if configGetter == nil {
+			return nil, nil, status.Errorf(codes.Internal, "configGetter arg required")
+		}
+		config, err := configGetter(ctx, cluster)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get config : %v", err))
+		}
+		dynamicClient, err := dynamic.NewForConfig(config)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get dynamic client : %v", err))
+		}
+		apiExtensions, err := apiext.NewForConfig(config)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get api extensions client : %v", err))
+		}
+		return dynamicClient, apiExtensions, nil
+	}
+}
+
+// newBackgroundConfigGetter returns the configGetter used outside of the
+// requests of users. The config of the Kubeapps cluster is returned by the
+// given configGetter, while the additional clusters are accessed with the
+// service token configured for them, as there is no user token to use.
+func newBackgroundConfigGetter(configGetter server.KubernetesConfigGetter, clustersConfig kubeutils.ClustersConfig) server.KubernetesConfigGetter {
+	return func(ctx context.Context, cluster string) (*rest.Config, error) {
+		if cluster == "" || cluster == clustersConfig.KubeappsClusterName {
+			if configGetter == nil {
+				return nil, status.Errorf(codes.Internal, "configGetter arg required")
+			}
+			return configGetter(ctx, cluster)
+		}
+		return kubeutils.NewClusterServiceConfig(cluster, clustersConfig)
+	}
+}
+
+// checkCluster returns an error if the requested cluster is not one of the
+// clusters configured for Kubeapps. An empty cluster refers to the cluster
+// on which Kubeapps is installed.
+func (s *Server) checkCluster(cluster string) error {
+	if cluster == "" || cluster == s.kubeappsCluster {
+		return nil
+	}
+	if _, ok := s.clustersConfig.Clusters[cluster]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Requests for cluster %q not supported: the cluster is not configured", cluster)
+	}
+	return nil
+}
+
+// clusterOrDefault returns the given cluster, or the cluster on which Kubeapps
+// is installed if it is empty.
+func (s *Server) clusterOrDefault(cluster string) string {
+	if cluster == "" {
+		return s.kubeappsCluster
+	}
+	return cluster
+}
+
+// getCache returns the cache of the flux HelmRepositories on the given cluster.
+func (s *Server) getCache(cluster string) (*NamespacedResourceWatcherCache, error) {
+	if err := s.checkCluster(cluster); err != nil {
+		return nil, err
+	}
+	cluster = s.clusterOrDefault(cluster)
+	cache, ok := s.caches[cluster]
+	if !ok || cache == nil {
+		if err, ok := s.cacheErrors[cluster]; ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "the cache of the fluxv2 helmrepositories on cluster [%s] could not be started: %v", cluster, err)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "server cache has not been properly initialized for cluster [%s]", cluster)
+	}
+	return cache, nil
+}
+
+// getDynamicClient returns a dynamic k8s client for the given cluster.
+func (s *Server) getDynamicClient(ctx context.Context, cluster string) (dynamic.Interface, error) {
 	if s.clientGetter == nil {
 		return nil, status.Errorf(codes.Internal, "server not configured with configGetter")
 	}
-	dynamicClient, _, err := s.clientGetter(ctx)
+	if err := s.checkCluster(cluster); err != nil {
+		return nil, err
+	}
+	dynamicClient, _, err := s.clientGetter(ctx, cluster)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "unable to get client due to: %v", err)
 	}
 	return dynamicClient, nil
 }
 
+// getResourceMapper returns a mapper from the kinds of resources to the
+// resources served by the given cluster.
+func (s *Server) getResourceMapper(ctx context.Context, cluster string) (meta.RESTMapper, error) {
+	if s.clientGetter == nil {
+		return nil, status.Errorf(codes.Internal, "server not configured with configGetter")
+	}
+	_, apiExt, err := s.clientGetter(ctx, cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "unable to get client due to: %v", err)
+	}
+	return server.NewResourceMapper(apiExt.Discovery()), nil
+}
+
 // ===== general note on error handling ========
 // using fmt.Errorf vs status.Errorf in functions exposed as grpc:
 //
@@ -160,14 +279,7 @@ func (s *Server) GetPackageRepositories(ctx context.Context, request *v1alpha1.G
 		return nil, status.Errorf(codes.InvalidArgument, "no context provided")
 	}
 
-	if request.Context.Cluster != "" {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			request.Context.Cluster)
-	}
-
-	repos, err := s.listReposInCluster(ctx, request.Context.Namespace)
+	repos, err := s.listReposInCluster(ctx, request.Context.Cluster, request.Context.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +297,73 @@ func (s *Server) GetPackageRepositories(ctx context.Context, request *v1alpha1.G
 	}, nil
 }
 
+// CreatePackageRepository creates a flux HelmRepository based on the request.
+func (s *Server) CreatePackageRepository(ctx context.Context, request *v1alpha1.CreatePackageRepositoryRequest) (*v1alpha1.CreatePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 CreatePackageRepository [%v]", request)
+
+	name, err := packageRepositoryName(request.GetContext(), request.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.createRepo(ctx, request.Context.Cluster, name, request.Url, request.Interval, request.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.CreatePackageRepositoryResponse{
+		Repository: repo,
+	}, nil
+}
+
+// UpdatePackageRepository updates a flux HelmRepository based on the request.
+func (s *Server) UpdatePackageRepository(ctx context.Context, request *v1alpha1.UpdatePackageRepositoryRequest) (*v1alpha1.UpdatePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 UpdatePackageRepository [%v]", request)
+
+	name, err := packageRepositoryName(request.GetContext(), request.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.updateRepo(ctx, request.Context.Cluster, name, request.Url, request.Interval, request.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.UpdatePackageRepositoryResponse{
+		Repository: repo,
+	}, nil
+}
+
+// DeletePackageRepository deletes a flux HelmRepository based on the request.
+func (s *Server) DeletePackageRepository(ctx context.Context, request *v1alpha1.DeletePackageRepositoryRequest) (*v1alpha1.DeletePackageRepositoryResponse, error) {
+	log.Infof("+fluxv2 DeletePackageRepository [%v]", request)
+
+	name, err := packageRepositoryName(request.GetContext(), request.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.deleteRepo(ctx, request.Context.Cluster, name); err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.DeletePackageRepositoryResponse{}, nil
+}
+
+// packageRepositoryName checks the context and name of a repository request
+// and returns the name of the flux HelmRepository CR it refers to.
+func packageRepositoryName(packageContext *corev1.Context, name string) (types.NamespacedName, error) {
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageContext.GetNamespace() == "" {
+		return types.NamespacedName{}, status.Errorf(codes.InvalidArgument, "no request Context namespace provided")
+	}
+	if name == "" {
+		return types.NamespacedName{}, status.Errorf(codes.InvalidArgument, "no request Name provided")
+	}
+	return types.NamespacedName{Namespace: packageContext.Namespace, Name: name}, nil
+}
+
 // GetAvailablePackageSummaries returns the available packages based on the request.
 // Note that currently packages are returned only from repos that are in a 'Ready'
 // state. For the fluxv2 plugin, the request context namespace (the target
@@ -193,14 +372,6 @@ func (s *Server) GetPackageRepositories(ctx context.Context, request *v1alpha1.G
 func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *corev1.GetAvailablePackageSummariesRequest) (*corev1.GetAvailablePackageSummariesResponse, error) {
 	log.Infof("+fluxv2 GetAvailablePackageSummaries(request: [%v])", request)
 
-	// grpc compiles in getters for you which automatically return a default (empty) struct if the pointer was nil
-	if request != nil && request.GetContext().GetCluster() != "" {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.Context.Cluster: [%v]",
-			request.Context.Cluster)
-	}
-
 	pageSize := request.GetPaginationOptions().GetPageSize()
 	pageOffset, err := pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
 	if err != nil {
@@ -210,32 +381,55 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 			request.GetPaginationOptions().GetPageToken(), err)
 	}
 
-	if s.cache == nil {
-		return nil, status.Errorf(
-			codes.FailedPrecondition,
-			"server cache has not been properly initialized")
+	// grpc compiles in getters for you which automatically return a default (empty) struct if the pointer was nil
+	cache, err := s.getCache(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
 	}
 
-	repos, err := s.cache.listKeys(request.GetFilterOptions().GetRepositories())
+	repos, err := cache.listKeys(request.GetFilterOptions().GetRepositories())
 	if err != nil {
 		return nil, err
 	}
 
-	cachedCharts, err := s.cache.fetchForMultiple(repos)
+	cachedCharts, err := cache.fetchForMultiple(repos)
 	if err != nil {
 		return nil, err
 	}
 
-	packageSummaries, err := filterAndPaginateCharts(request.GetFilterOptions(), pageSize, pageOffset, cachedCharts)
+	// charts may also come from GitRepositories and Buckets, whose cache keys
+	// never collide with those of HelmRepositories
+	artifactCaches, err := s.getArtifactCaches(request.GetContext().GetCluster())
+	if err != nil {
+		return nil, err
+	}
+	for _, artifactCache := range artifactCaches {
+		sources, err := artifactCache.listKeys(request.GetFilterOptions().GetRepositories())
+		if err != nil {
+			return nil, err
+		}
+		cachedArtifactCharts, err := artifactCache.fetchForMultiple(sources)
+		if err != nil {
+			return nil, err
+		}
+		for key, charts := range cachedArtifactCharts {
+			cachedCharts[key] = charts
+		}
+	}
+
+	packageSummaries, err := filterAndPaginateCharts(request.GetFilterOptions(), request.GetSortOptions(), pageSize, pageOffset, cachedCharts)
 	if err != nil {
 		return nil, err
 	}
+	for _, summary := range packageSummaries {
+		summary.GetAvailablePackageRef().GetContext().Cluster = request.GetContext().GetCluster()
+	}
 
 	// Only return a next page token if the request was for pagination and
-	// the results are a full page.
+	// the results are a full page. The token is the offset of the next item.
 	nextPageToken := ""
 	if pageSize > 0 && len(packageSummaries) == int(pageSize) {
-		nextPageToken = fmt.Sprintf("%d", pageOffset+1)
+		nextPageToken = fmt.Sprintf("%d", pageOffset+len(packageSummaries))
 	}
 	return &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: packageSummaries,
@@ -262,7 +456,10 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 	if err != nil {
 		return nil, err
 	}
-	packageIdParts := strings.Split(unescapedChartID, "/")
+	sourceKind, sourceName, chartSpec, err := sourceRefFromChartID(unescapedChartID)
+	if err != nil {
+		return nil, err
+	}
 
 	// check if the repo has been indexed, stored in the cache and requested
 	// package is part of it. Otherwise, there is a time window when this scenario can happen:
@@ -270,15 +467,28 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 	//   and said index is cached BUT
 	// - GetAvailablePackageDetail() may return full package detail for one of the packages
 	// in the repo
-	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageIdParts[0]}
-	ok, err := s.repoExistsInCache(name)
-	if err != nil {
-		return nil, err
-	} else if !ok {
-		return nil, status.Errorf(codes.NotFound, "no fully indexed repository [%s] has been found", name)
+	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: sourceName}
+	repoUrl := ""
+	if source, ok := artifactSourceForKind(sourceKind); ok {
+		// the HelmChart refers to the chart by its path within the artifact
+		// of the GitRepository or Bucket
+		chart, err := s.fetchArtifactChartFromCache(packageRef.Context.Cluster, source, name, chartSpec)
+		if err != nil {
+			return nil, err
+		} else if chart == nil {
+			return nil, status.Errorf(codes.NotFound, "no fully indexed %s [%s] with chart [%s] has been found", sourceKind, name, chartSpec)
+		}
+		repoUrl = chart.Repo.URL
+	} else {
+		ok, err := s.repoExistsInCache(packageRef.Context.Cluster, name)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, status.Errorf(codes.NotFound, "no fully indexed repository [%s] has been found", name)
+		}
 	}
 
-	tarUrl, err, cleanUp := s.getChartTarball(ctx, packageIdParts[0], packageIdParts[1], packageRef.Context.Namespace, request.PkgVersion)
+	tarUrl, err, cleanUp := s.getChartTarball(ctx, packageRef.Context.Cluster, sourceKind, sourceName, chartSpec, packageRef.Context.Namespace, request.PkgVersion)
 	if cleanUp != nil {
 		defer cleanUp()
 	}
@@ -293,12 +503,14 @@ func (s *Server) GetAvailablePackageDetail(ctx context.Context, request *corev1.
 	}
 
 	// fix up a couple of fields that don't come from the chart tarball
-	repoUrl, err := s.getRepoUrl(ctx, name)
-	if err != nil {
-		return nil, err
+	if sourceKind == fluxHelmRepository {
+		if repoUrl, err = s.getRepoUrl(ctx, packageRef.Context.Cluster, name); err != nil {
+			return nil, err
+		}
 	}
 	pkgDetail.RepoUrl = repoUrl
 	pkgDetail.AvailablePackageRef.Context.Namespace = packageRef.Context.Namespace
+	pkgDetail.AvailablePackageRef.Context.Cluster = packageRef.Context.Cluster
 
 	return &corev1.GetAvailablePackageDetailResponse{
 		AvailablePackageDetail: pkgDetail,
@@ -328,9 +540,12 @@ func (s *Server) GetAvailablePackageVersions(ctx context.Context, request *corev
 	}
 
 	log.Infof("Requesting chart [%s] (latest version) in ns [%s]", unescapedChartID, namespace)
-	packageIdParts := strings.Split(unescapedChartID, "/")
-	repo := types.NamespacedName{Namespace: namespace, Name: packageIdParts[0]}
-	chart, err := s.fetchChartFromCache(repo, packageIdParts[1])
+	sourceKind, sourceName, chartSpec, err := sourceRefFromChartID(unescapedChartID)
+	if err != nil {
+		return nil, err
+	}
+	repo := types.NamespacedName{Namespace: namespace, Name: sourceName}
+	chart, err := s.fetchChartFromCache(packageRef.GetContext().GetCluster(), sourceKind, repo, chartSpec)
 	if err != nil {
 		return nil, err
 	} else if chart != nil {
@@ -355,16 +570,16 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 			request.GetPaginationOptions().GetPageToken(), err)
 	}
 
-	installedPkgSummaries, err := s.paginatedInstalledPkgSummaries(ctx, request.GetContext().GetNamespace(), pageSize, pageOffset)
+	installedPkgSummaries, nextPageOffset, err := s.paginatedInstalledPkgSummaries(ctx, request.GetContext().GetCluster(), request.GetContext().GetNamespace(), pageSize, pageOffset)
 	if err != nil {
 		return nil, err
 	}
 
 	// Only return a next page token if the request was for pagination and
-	// the results are a full page.
+	// the results are a full page. The token is the offset of the next release.
 	nextPageToken := ""
-	if pageSize > 0 && len(installedPkgSummaries) == int(pageSize) {
-		nextPageToken = fmt.Sprintf("%d", pageOffset+1)
+	if nextPageOffset > 0 {
+		nextPageToken = fmt.Sprintf("%d", nextPageOffset)
 	}
 
 	response := &corev1.GetInstalledPackageSummariesResponse{
@@ -389,7 +604,7 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	}
 
 	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
-	pkgDetail, err := s.installedPackageDetail(ctx, name)
+	pkgDetail, err := s.installedPackageDetail(ctx, packageRef.Context.Cluster, name)
 	if err != nil {
 		return nil, err
 	}
@@ -412,11 +627,13 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	if request.TargetContext == nil || request.TargetContext.Namespace == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "no request TargetContext namespace provided")
 	}
-	if request.TargetContext.Cluster != "" {
-		return nil, status.Errorf(
-			codes.Unimplemented,
-			"not supported yet: request.TargetContext.Cluster: [%v]",
-			request.TargetContext.Cluster)
+	if len(request.ValueSources) > 0 {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: request.ValueSources")
+	}
+	// flux can only install the charts of the sources on the cluster of the
+	// release, so the available package must be on the same cluster
+	if availableCluster, targetCluster := s.clusterOrDefault(request.AvailablePackageRef.GetContext().GetCluster()), s.clusterOrDefault(request.TargetContext.Cluster); availableCluster != targetCluster {
+		return nil, status.Errorf(codes.InvalidArgument, "not supported: installing package from cluster [%s] on cluster [%s]", availableCluster, targetCluster)
 	}
 
 	targetName := types.NamespacedName{
@@ -424,7 +641,7 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		Namespace: request.TargetContext.Namespace,
 	}
 
-	installedRef, err := s.newRelease(ctx, request.AvailablePackageRef, targetName)
+	installedRef, err := s.newRelease(ctx, request.TargetContext.Cluster, request.AvailablePackageRef, targetName, request.PkgVersionReference, request.ReconciliationOptions, request.Values)
 	if err != nil {
 		return nil, err
 	}
@@ -433,3 +650,137 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 		InstalledPackageRef: installedRef,
 	}, nil
 }
+
+// UpdateInstalledPackage updates an installed package based on the request.
+func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.UpdateInstalledPackageRequest) (*corev1.UpdateInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 UpdateInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	packageRef := request.InstalledPackageRef
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+	}
+	if len(request.ValueSources) > 0 {
+		return nil, status.Errorf(codes.Unimplemented, "not supported yet: request.ValueSources")
+	}
+
+	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
+	installedRef, err := s.updateRelease(ctx, packageRef.Context.Cluster, name, request.PkgVersionReference, request.ReconciliationOptions, request.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.UpdateInstalledPackageResponse{
+		InstalledPackageRef: installedRef,
+	}, nil
+}
+
+// DeleteInstalledPackage deletes an installed package based on the request.
+func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	log.Infof("+fluxv2 DeleteInstalledPackage [%v]", request)
+
+	if request == nil || request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+
+	packageRef := request.InstalledPackageRef
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+	}
+
+	// flux helm-controller always uninstalls the helm release when the
+	// HelmRelease CR is deleted, so there is no way to honor KeepHistory here.
+	name := types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}
+	if err := s.deleteRelease(ctx, packageRef.Context.Cluster, name); err != nil {
+		return nil, err
+	}
+
+	return &corev1.DeleteInstalledPackageResponse{}, nil
+}
+
+// GetInstalledPackageResourceRefs returns the references for the Kubernetes
+// resources created by the helm release of an installed package.
+func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
+	log.Infof("+fluxv2 GetInstalledPackageResourceRefs [%v]", request)
+
+	name, err := installedPackageNameFromRef(request.GetInstalledPackageRef())
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := request.GetInstalledPackageRef().GetContext().GetCluster()
+	refs, err := s.resourceRefsForRelease(ctx, cluster, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageResourceRefsResponse{
+		ResourceRefs: refs,
+	}, nil
+}
+
+// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes
+// resources created by the helm release of an installed package.
+func (s *Server) GetInstalledPackageResourceStatuses(ctx context.Context, request *corev1.GetInstalledPackageResourceStatusesRequest) (*corev1.GetInstalledPackageResourceStatusesResponse, error) {
+	log.Infof("+fluxv2 GetInstalledPackageResourceStatuses [%v]", request)
+
+	name, err := installedPackageNameFromRef(request.GetInstalledPackageRef())
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := request.GetInstalledPackageRef().GetContext().GetCluster()
+	refs, err := s.resourceRefsForRelease(ctx, cluster, name)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.getDynamicClient(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := s.getResourceMapper(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageResourceStatusesResponse{
+		ResourceStatuses: server.GetResourceStatuses(ctx, client, mapper, refs),
+	}, nil
+}
+
+// installedPackageNameFromRef checks the installed package reference and
+// returns the name of the flux HelmRelease CR it refers to.
+func installedPackageNameFromRef(packageRef *corev1.InstalledPackageReference) (types.NamespacedName, error) {
+	if packageRef == nil {
+		return types.NamespacedName{}, status.Errorf(codes.InvalidArgument, "no request InstalledPackageRef provided")
+	}
+	// flux CRDs require a namespace, cluster-wide resources are not supported
+	if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
+		return types.NamespacedName{}, status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+	}
+	return types.NamespacedName{Namespace: packageRef.Context.Namespace, Name: packageRef.Identifier}, nil
+}
+
+// WatchInstalledPackages streams the status of the installed packages as it
+// changes, by watching the flux HelmRelease CRs.
+func (s *Server) WatchInstalledPackages(request *corev1.WatchInstalledPackagesRequest, stream corev1.PackagesService_WatchInstalledPackagesServer) error {
+	log.Infof("+fluxv2 WatchInstalledPackages [%v]", request)
+
+	packageContext := request.GetContext()
+	name := ""
+	if packageRef := request.GetInstalledPackageRef(); packageRef != nil {
+		// flux CRDs require a namespace, cluster-wide resources are not supported
+		if packageRef.Context == nil || len(packageRef.Context.Namespace) == 0 {
+			return status.Errorf(codes.InvalidArgument, "InstalledPackageReference is missing required 'namespace' field")
+		}
+		packageContext = packageRef.Context
+		name = packageRef.Identifier
+	}
+	return s.watchReleases(stream, packageContext.GetCluster(), packageContext.GetNamespace(), name)
+}
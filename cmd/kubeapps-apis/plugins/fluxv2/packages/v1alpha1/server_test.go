@@ -16,6 +16,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
@@ -23,16 +24,21 @@ import (
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/fluxv2/packages/v1alpha1"
+	kubeutils "github.com/kubeapps/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"helm.sh/helm/v3/pkg/action"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
 )
 
 func TestBadClientGetter(t *testing.T) {
@@ -48,7 +54,7 @@ func TestBadClientGetter(t *testing.T) {
 		},
 		{
 			name: "returns failed-precondition when clientGetter itself errors",
-			clientGetter: func(context.Context) (dynamic.Interface, apiext.Interface, error) {
+			clientGetter: func(context.Context, string) (dynamic.Interface, apiext.Interface, error) {
 				return nil, nil, fmt.Errorf("Bang!")
 			},
 			statusCode: codes.FailedPrecondition,
@@ -74,6 +80,69 @@ func TestBadClientGetter(t *testing.T) {
 	}
 }
 
+func TestRequestsForClusters(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cluster    string
+		statusCode codes.Code
+	}{
+		{
+			name:       "returns without error for the default cluster",
+			cluster:    "",
+			statusCode: codes.OK,
+		},
+		{
+			name:       "returns without error for the kubeapps cluster",
+			cluster:    kubeappsCluster,
+			statusCode: codes.OK,
+		},
+		{
+			name:       "returns invalid argument for a cluster which is not configured",
+			cluster:    "other",
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, mock, _, err := newServerWithRepos()
+			if err != nil {
+				t.Fatalf("error instantiating the server: %v", err)
+			}
+
+			_, err = s.GetPackageRepositories(context.Background(), &v1alpha1.GetPackageRepositoriesRequest{
+				Context: &corev1.Context{Cluster: tc.cluster, Namespace: "default"},
+			})
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Errorf("GetPackageRepositories: got: %+v, want: %+v", got, want)
+			}
+
+			if tc.statusCode != codes.OK {
+				_, err = s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+					Context: &corev1.Context{Cluster: tc.cluster},
+				})
+				if got, want := status.Code(err), tc.statusCode; got != want {
+					t.Errorf("GetAvailablePackageSummaries: got: %+v, want: %+v", got, want)
+				}
+
+				_, err = s.GetInstalledPackageDetail(context.Background(), &corev1.GetInstalledPackageDetailRequest{
+					InstalledPackageRef: &corev1.InstalledPackageReference{
+						Context:    &corev1.Context{Cluster: tc.cluster, Namespace: "default"},
+						Identifier: "my-podinfo",
+					},
+				})
+				if got, want := status.Code(err), tc.statusCode; got != want {
+					t.Errorf("GetInstalledPackageDetail: got: %+v, want: %+v", got, want)
+				}
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("%v", err)
+			}
+		})
+	}
+}
+
 func TestGetAvailablePackagesStatus(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -202,6 +271,191 @@ func TestGetAvailablePackagesStatus(t *testing.T) {
 // utilities
 //
 
+func TestRequestsForSecondCluster(t *testing.T) {
+	ts, repo, err := newRepoWithIndex("testdata/podinfo-index.yaml", "podinfo", "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer ts.Close()
+
+	s, mock, _, err := newServerWithRepos()
+	if err != nil {
+		t.Fatalf("error instantiating the server: %v", err)
+	}
+
+	// the second cluster has its own client and its own cache, which shares
+	// the redis client of the kubeapps cluster as it does when deployed.
+	const otherCluster = "other"
+	otherClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: fluxGroup, Version: fluxVersion, Resource: fluxHelmRepositories}: fluxHelmRepositoryList,
+		},
+		repo)
+	kubeappsClientGetter := s.clientGetter
+	s.clientGetter = func(ctx context.Context, cluster string) (dynamic.Interface, apiext.Interface, error) {
+		if cluster == otherCluster {
+			return otherClient, apiextfake.NewSimpleClientset(fluxHelmRepositoryCRD), nil
+		}
+		return kubeappsClientGetter(ctx, cluster)
+	}
+	s.clustersConfig.Clusters[otherCluster] = kubeutils.ClusterConfig{Name: otherCluster}
+	otherCache := &NamespacedResourceWatcherCache{
+		config: cacheConfig{
+			gvr: schema.GroupVersionResource{
+				Group:    fluxGroup,
+				Version:  fluxVersion,
+				Resource: fluxHelmRepositories,
+			},
+			cluster: otherCluster,
+			onGet:   onGetRepo,
+		},
+		redisCli: s.caches[kubeappsCluster].redisCli,
+	}
+	s.caches[otherCluster] = otherCache
+
+	key := otherCache.keyForNamespacedName(types.NamespacedName{Namespace: "default", Name: "podinfo"})
+	bytes, _, err := onAddOrModifyRepo(key, repo.Object)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	t.Run("the repositories are those of the second cluster", func(t *testing.T) {
+		response, err := s.GetPackageRepositories(context.Background(), &v1alpha1.GetPackageRepositoriesRequest{
+			Context: &corev1.Context{Cluster: otherCluster, Namespace: "default"},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(response.Repositories), 1; got != want {
+			t.Fatalf("got: %d, want: %d", got, want)
+		}
+		if got, want := response.Repositories[0].Name, "podinfo"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+
+		response, err = s.GetPackageRepositories(context.Background(), &v1alpha1.GetPackageRepositoriesRequest{
+			Context: &corev1.Context{Cluster: kubeappsCluster, Namespace: "default"},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(response.Repositories), 0; got != want {
+			t.Errorf("got: %d, want: %d", got, want)
+		}
+	})
+
+	t.Run("the available packages are read from the cache of the second cluster", func(t *testing.T) {
+		mock.ExpectScan(0, fluxHelmRepositories+":"+otherCluster+":*", 0).SetVal([]string{key}, 0)
+		mock.ExpectGet(key).SetVal(string(bytes.([]byte)))
+
+		response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+			Context: &corev1.Context{Cluster: otherCluster},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(response.AvailablePackageSummaries), 1; got != want {
+			t.Fatalf("got: %d, want: %d", got, want)
+		}
+		ref := response.AvailablePackageSummaries[0].GetAvailablePackageRef()
+		if got, want := ref.GetIdentifier(), "podinfo/podinfo"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if got, want := ref.GetContext().GetCluster(), otherCluster; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%v", err)
+		}
+	})
+
+	t.Run("the reason is returned when the cache of a cluster could not be started", func(t *testing.T) {
+		s.clustersConfig.Clusters["broken"] = kubeutils.ClusterConfig{Name: "broken"}
+		s.cacheErrors = map[string]error{"broken": fmt.Errorf("cluster \"broken\" has no service token configured")}
+
+		_, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+			Context: &corev1.Context{Cluster: "broken"},
+		})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Fatalf("got: %+v, want: %+v", got, want)
+		}
+		if !strings.Contains(err.Error(), "has no service token configured") {
+			t.Errorf("got: %q, want the reason of the failure", err.Error())
+		}
+	})
+}
+
+func TestNewBackgroundConfigGetter(t *testing.T) {
+	kubeappsConfig := &rest.Config{Host: "https://kubeapps.example.com"}
+	configGetter := func(context.Context, string) (*rest.Config, error) {
+		return kubeappsConfig, nil
+	}
+	clustersConfig := kubeutils.ClustersConfig{
+		KubeappsClusterName: kubeappsCluster,
+		Clusters: map[string]kubeutils.ClusterConfig{
+			kubeappsCluster: {Name: kubeappsCluster},
+			"other": {
+				Name:          "other",
+				APIServiceURL: "https://other.example.com",
+				ServiceToken:  "other-service-token",
+			},
+			"no-token": {
+				Name:          "no-token",
+				APIServiceURL: "https://no-token.example.com",
+			},
+		},
+	}
+	backgroundConfigGetter := newBackgroundConfigGetter(configGetter, clustersConfig)
+
+	testCases := []struct {
+		name          string
+		cluster       string
+		expectedHost  string
+		expectedToken string
+		errorExpected bool
+	}{
+		{
+			name:         "uses the config of the kubeapps cluster",
+			cluster:      kubeappsCluster,
+			expectedHost: "https://kubeapps.example.com",
+		},
+		{
+			name:         "uses the config of the kubeapps cluster by default",
+			cluster:      "",
+			expectedHost: "https://kubeapps.example.com",
+		},
+		{
+			name:          "uses the service token of an additional cluster",
+			cluster:       "other",
+			expectedHost:  "https://other.example.com",
+			expectedToken: "other-service-token",
+		},
+		{
+			name:          "returns an error for an additional cluster without service token",
+			cluster:       "no-token",
+			errorExpected: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := backgroundConfigGetter(context.Background(), tc.cluster)
+			if got, want := err != nil, tc.errorExpected; got != want {
+				t.Fatalf("got: %t, want: %t, err: %+v", got, want, err)
+			}
+			if tc.errorExpected {
+				return
+			}
+			if got, want := config.Host, tc.expectedHost; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := config.BearerToken, tc.expectedToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
 // This func does not create a kubernetes dynamic client. It is meant to work in conjunction with
 // a call to fake.NewSimpleDynamicClientWithCustomListKinds. The reason for argument repos
 // (unlike charts or releases) is that repos are treated special because
@@ -220,6 +474,7 @@ func newServer(clientGetter clientGetter, actionConfig *action.Configuration, re
 	}
 	config := cacheConfig{
 		gvr:          repositoriesGvr,
+		cluster:      kubeappsCluster,
 		clientGetter: clientGetter,
 		onAdd:        onAddOrModifyRepo,
 		onModify:     onAddOrModifyRepo,
@@ -227,6 +482,14 @@ func newServer(clientGetter clientGetter, actionConfig *action.Configuration, re
 		onDelete:     onDeleteRepo,
 	}
 
+	if clientGetter != nil {
+		// the initial resync, which only happens when the cluster can be reached,
+		// clears the keys of the cluster before populating them
+		if _, _, err := clientGetter(context.Background(), kubeappsCluster); err == nil {
+			mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{}, 0)
+		}
+	}
+
 	eventProcessingWaitGroup := &sync.WaitGroup{}
 	for _, r := range repos {
 		eventProcessingWaitGroup.Add(1)
@@ -252,10 +515,17 @@ func newServer(clientGetter clientGetter, actionConfig *action.Configuration, re
 
 	s := &Server{
 		clientGetter: clientGetter,
-		actionConfigGetter: func(context.Context, string) (*action.Configuration, error) {
+		actionConfigGetter: func(context.Context, string, string) (*action.Configuration, error) {
 			return actionConfig, nil
 		},
-		cache: cache,
+		kubeappsCluster: kubeappsCluster,
+		clustersConfig: kubeutils.ClustersConfig{
+			KubeappsClusterName: kubeappsCluster,
+			Clusters: map[string]kubeutils.ClusterConfig{
+				kubeappsCluster: {Name: kubeappsCluster},
+			},
+		},
+		caches: map[string]*NamespacedResourceWatcherCache{kubeappsCluster: cache},
 	}
 	return s, mock, nil
 }
@@ -270,6 +540,9 @@ func lessPackageRepositoryFunc(p1, p2 *v1alpha1.PackageRepository) bool {
 }
 
 // misc global vars that get re-used in multiple tests
+// the cluster on which Kubeapps is installed in the tests
+const kubeappsCluster = "default"
+
 var fluxPlugin = &plugins.Plugin{Name: "fluxv2.packages", Version: "v1alpha1"}
 var fluxHelmRepositoryCRD = &apiextv1.CustomResourceDefinition{
 	TypeMeta: metav1.TypeMeta{
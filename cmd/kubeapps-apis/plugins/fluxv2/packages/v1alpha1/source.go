@@ -0,0 +1,468 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubeapps/kubeapps/pkg/chart/models"
+	httpclient "github.com/kubeapps/kubeapps/pkg/http-client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/helm/pkg/proto/hapi/chart"
+	log "k8s.io/klog/v2"
+)
+
+// Besides HelmRepositories, flux can build charts out of the artifacts of
+// GitRepository and Bucket sources, in which case the HelmChart spec.chart
+// is the path of the chart within the artifact rather than its name.
+// see https://fluxcd.io/docs/components/source/helmcharts/
+
+const (
+	// see docs at https://fluxcd.io/docs/components/source/gitrepositories/
+	// and https://fluxcd.io/docs/components/source/buckets/
+	fluxGitRepository   = "GitRepository"
+	fluxGitRepositories = "gitrepositories"
+	fluxBucket          = "Bucket"
+	fluxBuckets         = "buckets"
+
+	// the name of the file which identifies a chart directory within an artifact
+	chartYamlFileName = "Chart.yaml"
+
+	// the maximum size of the decompressed contents of an artifact, and of a
+	// Chart.yaml file within it, beyond which the artifact is not indexed or the
+	// chart is skipped, respectively
+	maxArtifactSize  = 512 * 1024 * 1024
+	maxChartYamlSize = 1024 * 1024
+)
+
+// artifactSource describes a kind of flux source whose artifact is a tarball
+// that may contain any number of charts.
+type artifactSource struct {
+	kind     string
+	resource string
+	// the models.Repo type of the charts found in the artifacts of this kind
+	repoType string
+}
+
+var artifactSources = []artifactSource{
+	{kind: fluxGitRepository, resource: fluxGitRepositories, repoType: "git"},
+	{kind: fluxBucket, resource: fluxBuckets, repoType: "bucket"},
+}
+
+func artifactSourceForKind(kind string) (artifactSource, bool) {
+	for _, source := range artifactSources {
+		if source.kind == kind {
+			return source, true
+		}
+	}
+	return artifactSource{}, false
+}
+
+func artifactSourceForRepoType(repoType string) (artifactSource, bool) {
+	for _, source := range artifactSources {
+		if source.repoType == repoType {
+			return source, true
+		}
+	}
+	return artifactSource{}, false
+}
+
+func (source artifactSource) gvr() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    fluxGroup,
+		Version:  fluxVersion,
+		Resource: source.resource,
+	}
+}
+
+// artifactChartPath returns the path of a chart within an artifact relative to
+// its root, which is "." for a chart at the root of the artifact.
+func artifactChartPath(chartPath string) string {
+	return path.Clean(strings.TrimPrefix(chartPath, "/"))
+}
+
+// artifactChartName returns a name for the chart found at chartPath within the
+// artifact of the named source, i.e. the name of the chart directory, or the name
+// of the source for a chart at the root of the artifact. It is used to name the
+// HelmCharts but does not identify the chart, since several directories of an
+// artifact may have the same name, e.g. apps/web and legacy/web.
+func artifactChartName(sourceName, chartPath string) string {
+	name := path.Base(artifactChartPath(chartPath))
+	if name == "." || name == "/" {
+		return sourceName
+	}
+	return name
+}
+
+// artifactChartID returns the identifier of the chart found at chartPath within
+// the artifact of the named source, e.g. "monorepo/gitrepositories/apps/web".
+// Unlike the "<repo>/<chart>" identifiers of the charts of HelmRepositories, it
+// includes the resource of the kind of source, so that the source of a chart can
+// be told from its identifier alone.
+func artifactChartID(source artifactSource, sourceName, chartPath string) string {
+	return fmt.Sprintf("%s/%s/%s", sourceName, source.resource, artifactChartPath(chartPath))
+}
+
+// chartSourceRef returns the flux source kind and the HelmChart spec.chart
+// for a chart from the cache.
+func chartSourceRef(chart *models.Chart) (kind, chartSpec string) {
+	if source, ok := artifactSourceForRepoType(chart.Repo.Type); ok {
+		if len(chart.ChartVersions) > 0 && len(chart.ChartVersions[0].URLs) > 0 {
+			return source.kind, chart.ChartVersions[0].URLs[0]
+		}
+		return source.kind, "."
+	}
+	return fluxHelmRepository, chart.Name
+}
+
+// chartNameFromSourceRef converts the spec.chart of a HelmChart or HelmRelease
+// into a name for the chart, see artifactChartName.
+func chartNameFromSourceRef(kind, sourceName, chartSpec string) string {
+	if _, ok := artifactSourceForKind(kind); ok {
+		return artifactChartName(sourceName, chartSpec)
+	}
+	return chartSpec
+}
+
+// chartIDFromSourceRef converts the source and spec.chart of a HelmChart or
+// HelmRelease into the identifier of the chart.
+func chartIDFromSourceRef(kind, sourceName, chartSpec string) string {
+	if source, ok := artifactSourceForKind(kind); ok {
+		return artifactChartID(source, sourceName, chartSpec)
+	}
+	return fmt.Sprintf("%s/%s", sourceName, chartSpec)
+}
+
+// sourceRefFromChartID is the reverse of chartIDFromSourceRef: it returns the
+// kind and name of the source of the chart with the given identifier, along
+// with the spec.chart referring to it, i.e. its name within a HelmRepository or
+// its path within the artifact of a GitRepository or Bucket.
+func sourceRefFromChartID(chartID string) (kind, sourceName, chartSpec string, err error) {
+	parts := strings.SplitN(chartID, "/", 3)
+	if len(parts) == 2 {
+		return fluxHelmRepository, parts[0], parts[1], nil
+	}
+	if len(parts) == 3 {
+		for _, source := range artifactSources {
+			if parts[1] == source.resource {
+				return source.kind, parts[0], parts[2], nil
+			}
+		}
+	}
+	return "", "", "", status.Errorf(codes.InvalidArgument, "Incorrect request.AvailablePackageRef.Identifier, unknown kind of source: %s", chartID)
+}
+
+// newArtifactCaches creates the caches of the GitRepositories and Buckets on the
+// given cluster. Charts from HelmRepositories remain available without these, so
+// failing to create any of them is not fatal.
+func newArtifactCaches(cluster string, clientGetter clientGetter) []*NamespacedResourceWatcherCache {
+	caches := []*NamespacedResourceWatcherCache{}
+	for _, source := range artifactSources {
+		cacheConfig := cacheConfig{
+			gvr:          source.gvr(),
+			cluster:      cluster,
+			clientGetter: clientGetter,
+			onAdd:        onAddOrModifyArtifactSource,
+			onModify:     onAddOrModifyArtifactSource,
+			onGet:        onGetRepo,
+			onDelete:     onDeleteRepo,
+		}
+		cache, err := newCache(cacheConfig)
+		if err != nil {
+			log.Errorf("Unable to create the cache of the fluxv2 %s on cluster [%s] due to: %v", source.resource, cluster, err)
+			continue
+		}
+		caches = append(caches, cache)
+	}
+	return caches
+}
+
+// getArtifactCaches returns the caches of the GitRepositories and Buckets on the
+// given cluster. Unlike with HelmRepositories, there may be none of them, e.g.
+// when the source CRDs could not be found.
+func (s *Server) getArtifactCaches(cluster string) ([]*NamespacedResourceWatcherCache, error) {
+	if err := s.checkCluster(cluster); err != nil {
+		return nil, err
+	}
+	return s.artifactCaches[s.clusterOrDefault(cluster)], nil
+}
+
+// fetchArtifactChartFromCache looks up the chart at the given path within the
+// artifact of the named source of the given kind.
+func (s *Server) fetchArtifactChartFromCache(cluster string, source artifactSource, name types.NamespacedName, chartPath string) (*models.Chart, error) {
+	caches, err := s.getArtifactCaches(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	chartID := artifactChartID(source, name.Name, chartPath)
+	for _, cache := range caches {
+		if cache.config.gvr != source.gvr() {
+			continue
+		}
+		charts, err := cache.fetchForOne(cache.keyForNamespacedName(name))
+		if err != nil {
+			return nil, err
+		} else if charts == nil {
+			continue
+		}
+		typedCharts, ok := charts.([]models.Chart)
+		if !ok {
+			return nil, status.Errorf(
+				codes.Internal,
+				"unexpected value fetched from cache: %v", charts)
+		}
+		for _, chart := range typedCharts {
+			if chart.ID == chartID {
+				return &chart, nil // found it
+			}
+		}
+	}
+	return nil, nil
+}
+
+func indexOneArtifactSource(unstructuredSource map[string]interface{}) ([]models.Chart, error) {
+	startTime := time.Now()
+
+	name, err := namespacedName(unstructuredSource)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, _, _ := unstructured.NestedString(unstructuredSource, "kind")
+	source, ok := artifactSourceForKind(kind)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unsupported kind of source [%s] for [%s]", kind, name)
+	}
+
+	if !isRepoReady(unstructuredSource) {
+		return nil, status.Errorf(codes.Internal,
+			"cannot index %s [%s] because it is not in 'Ready' state", kind, name)
+	}
+
+	artifactUrl, found, err := unstructured.NestedString(unstructuredSource, "status", "artifact", "url")
+	if err != nil || !found || artifactUrl == "" {
+		return nil, status.Errorf(codes.Internal,
+			"expected field status.artifact.url not found on %s\n[%s], error %v",
+			kind, name, err)
+	}
+
+	log.Infof("indexOneArtifactSource: [%s] [%s], artifact URL: [%s]", kind, name, artifactUrl)
+
+	// as with the index of a HelmRepository, the artifact is served by the
+	// source-controller within the cluster, e.g.
+	// http://source-controller.flux-system.svc.cluster.local./gitrepository/default/podinfo/<revision>.tar.gz
+	// so no credentials are required to fetch it
+	artifact, _, err := httpclient.GetStream(artifactUrl, httpclient.New(), map[string]string{})
+	if artifact != nil {
+		defer artifact.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modelRepo := &models.Repo{
+		Namespace: name.Namespace,
+		Name:      name.Name,
+		URL:       artifactSourceUrl(kind, unstructuredSource),
+		Type:      source.repoType,
+	}
+
+	charts, err := chartsFromArtifact(artifact, modelRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Since(startTime)
+	log.Infof("indexOneArtifactSource: indexed [%d] packages in %s [%s] in [%d] ms", len(charts), kind, name, duration.Milliseconds())
+	return charts, nil
+}
+
+// artifactSourceUrl returns the location of the contents of the source, for display purposes
+func artifactSourceUrl(kind string, unstructuredSource map[string]interface{}) string {
+	if kind == fluxBucket {
+		endpoint, _, _ := unstructured.NestedString(unstructuredSource, "spec", "endpoint")
+		bucketName, _, _ := unstructured.NestedString(unstructuredSource, "spec", "bucketName")
+		return fmt.Sprintf("%s/%s", endpoint, bucketName)
+	}
+	url, _, _ := unstructured.NestedString(unstructuredSource, "spec", "url")
+	return url
+}
+
+// chartsFromArtifact discovers the charts in a .tar.gz artifact, i.e. the
+// directories containing a Chart.yaml file. Charts vendored within the charts/
+// directory of another chart are dependencies rather than charts in their own
+// right, so they are skipped. The artifact is read as a stream, up to
+// maxArtifactSize bytes once decompressed.
+func chartsFromArtifact(artifact io.Reader, repo *models.Repo) ([]models.Chart, error) {
+	gzipReader, err := gzip.NewReader(artifact)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read artifact of [%s/%s]: %v", repo.Namespace, repo.Name, err)
+	}
+	defer gzipReader.Close()
+
+	metadataByDir := map[string]*chart.Metadata{}
+	tarReader := tar.NewReader(newSizeLimitedReader(gzipReader, maxArtifactSize))
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to read artifact of [%s/%s]: %v", repo.Namespace, repo.Name, err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Base(header.Name) != chartYamlFileName {
+			continue
+		}
+		dir := path.Dir(artifactChartPath(header.Name))
+		if header.Size > maxChartYamlSize {
+			log.Warningf("Skipping chart [%s] in artifact of [%s/%s]: %s exceeds the maximum size of %d bytes", dir, repo.Namespace, repo.Name, chartYamlFileName, maxChartYamlSize)
+			continue
+		}
+		contents, err := ioutil.ReadAll(io.LimitReader(tarReader, maxChartYamlSize))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to read artifact of [%s/%s]: %v", repo.Namespace, repo.Name, err)
+		}
+		var metadata chart.Metadata
+		if err = yaml.Unmarshal(contents, &metadata); err != nil || metadata.Name == "" || metadata.Version == "" {
+			log.Warningf("Skipping invalid chart [%s] in artifact of [%s/%s]: %v", dir, repo.Namespace, repo.Name, err)
+			continue
+		}
+		metadataByDir[dir] = &metadata
+	}
+
+	charts := []models.Chart{}
+	for dir, metadata := range metadataByDir {
+		if isSubchartDir(dir, metadataByDir) {
+			continue
+		}
+		charts = append(charts, newArtifactChart(dir, metadata, repo))
+	}
+	sort.Slice(charts, func(i, j int) bool {
+		return charts[i].ID < charts[j].ID
+	})
+	return charts, nil
+}
+
+// sizeLimitedReader reads from the underlying reader up to a number of bytes.
+// Unlike an io.LimitedReader, it fails rather than reporting the end of the
+// stream once the limit is exceeded, so that a truncated artifact is not
+// mistaken for a complete one.
+type sizeLimitedReader struct {
+	reader    io.Reader
+	limit     int64
+	remaining int64
+}
+
+func newSizeLimitedReader(reader io.Reader, limit int64) *sizeLimitedReader {
+	return &sizeLimitedReader{reader: reader, limit: limit, remaining: limit}
+}
+
+func (r *sizeLimitedReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if r.remaining <= 0 {
+		// the limit is only exceeded if there is anything left to read
+		if n, err := r.reader.Read(p[:1]); n == 0 {
+			return 0, err
+		}
+		return 0, fmt.Errorf("artifact exceeds the maximum size of %d bytes", r.limit)
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	return n, err
+}
+
+func isSubchartDir(dir string, metadataByDir map[string]*chart.Metadata) bool {
+	for parent := range metadataByDir {
+		prefix := parent + "/charts/"
+		if parent == "." {
+			prefix = "charts/"
+		}
+		if strings.HasPrefix(dir, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// newArtifactChart builds the model of the chart found in the given directory
+// of an artifact. The artifact holds a single version of each chart, the path of
+// which is kept as the URL of that version.
+func newArtifactChart(dir string, metadata *chart.Metadata, repo *models.Repo) models.Chart {
+	source, _ := artifactSourceForRepoType(repo.Type)
+	maintainers := []chart.Maintainer{}
+	for _, maintainer := range metadata.Maintainers {
+		maintainers = append(maintainers, *maintainer)
+	}
+	return models.Chart{
+		ID:          artifactChartID(source, repo.Name, dir),
+		Name:        metadata.Name,
+		Repo:        repo,
+		Description: metadata.Description,
+		Home:        metadata.Home,
+		Keywords:    metadata.Keywords,
+		Maintainers: maintainers,
+		Sources:     metadata.Sources,
+		Icon:        metadata.Icon,
+		Category:    metadata.Annotations["category"],
+		ChartVersions: []models.ChartVersion{
+			{
+				Version:    metadata.Version,
+				AppVersion: metadata.AppVersion,
+				URLs:       []string{dir},
+			},
+		},
+	}
+}
+
+//
+// implements plug-in specific cache-related functionality
+//
+
+// onAddOrModifyArtifactSource tells the cache what to store for a given GitRepository or Bucket
+func onAddOrModifyArtifactSource(key string, unstructuredSource map[string]interface{}) (interface{}, bool, error) {
+	if isRepoReady(unstructuredSource) {
+		charts, err := indexOneArtifactSource(unstructuredSource)
+		if err != nil {
+			return nil, false, err
+		}
+
+		jsonBytes, err := json.Marshal(charts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return jsonBytes, true, nil
+	} else {
+		log.Infof("Skipping packages for source [%s] because it is not in 'Ready' state", key)
+		return nil, false, nil
+	}
+}
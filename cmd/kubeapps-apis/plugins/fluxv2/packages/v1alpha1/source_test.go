@@ -0,0 +1,403 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/pkg/chart/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/helm/pkg/proto/hapi/chart"
+)
+
+// a monorepo with three charts, two of which are in directories with the same
+// name and one of which vendors a dependency, as well as a directory which is
+// not a valid chart
+var monorepoFiles = map[string]string{
+	"README.md":                              "# monorepo",
+	"charts/podinfo/Chart.yaml":              "apiVersion: v2\nname: podinfo\nversion: 6.0.0\nappVersion: 6.0.0\ndescription: Podinfo Helm chart for Kubernetes\n",
+	"charts/podinfo/values.yaml":             "replicaCount: 1\n",
+	"charts/podinfo/charts/redis/Chart.yaml": "apiVersion: v2\nname: redis\nversion: 14.0.0\n",
+	"apps/web/Chart.yaml":                    "apiVersion: v2\nname: webapp\nversion: 0.1.0\nannotations:\n  category: Web\n",
+	"apps/broken/Chart.yaml":                 "apiVersion: v2\ndescription: no name nor version\n",
+	"legacy/web/Chart.yaml":                  "apiVersion: v1\nname: web\nversion: 0.0.1\n",
+}
+
+func TestIndexOneArtifactSource(t *testing.T) {
+	ts, source := newGitRepositoryWithArtifact(t, "monorepo", "default", monorepoFiles)
+	defer ts.Close()
+
+	charts, err := indexOneArtifactSource(source.Object)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	repo := &models.Repo{
+		Namespace: "default",
+		Name:      "monorepo",
+		URL:       "https://github.com/example/monorepo",
+		Type:      "git",
+	}
+	expectedCharts := []models.Chart{
+		{
+			ID:          "monorepo/gitrepositories/apps/web",
+			Name:        "webapp",
+			Repo:        repo,
+			Maintainers: []chart.Maintainer{},
+			Category:    "Web",
+			ChartVersions: []models.ChartVersion{
+				{Version: "0.1.0", URLs: []string{"apps/web"}},
+			},
+		},
+		{
+			ID:          "monorepo/gitrepositories/charts/podinfo",
+			Name:        "podinfo",
+			Repo:        repo,
+			Description: "Podinfo Helm chart for Kubernetes",
+			Maintainers: []chart.Maintainer{},
+			ChartVersions: []models.ChartVersion{
+				{Version: "6.0.0", AppVersion: "6.0.0", URLs: []string{"charts/podinfo"}},
+			},
+		},
+		{
+			ID:          "monorepo/gitrepositories/legacy/web",
+			Name:        "web",
+			Repo:        repo,
+			Maintainers: []chart.Maintainer{},
+			ChartVersions: []models.ChartVersion{
+				{Version: "0.0.1", URLs: []string{"legacy/web"}},
+			},
+		},
+	}
+	if got, want := charts, expectedCharts; !cmp.Equal(got, want) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestArtifactChartName(t *testing.T) {
+	testCases := []struct {
+		chartPath string
+		expected  string
+	}{
+		{chartPath: "charts/podinfo", expected: "podinfo"},
+		{chartPath: "./charts/podinfo/", expected: "podinfo"},
+		{chartPath: "podinfo", expected: "podinfo"},
+		{chartPath: ".", expected: "monorepo"},
+		{chartPath: "./", expected: "monorepo"},
+		{chartPath: "/", expected: "monorepo"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.chartPath, func(t *testing.T) {
+			if got, want := artifactChartName("monorepo", tc.chartPath), tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestChartIDFromSourceRef(t *testing.T) {
+	testCases := []struct {
+		kind      string
+		chartSpec string
+		expected  string
+	}{
+		{kind: fluxHelmRepository, chartSpec: "podinfo", expected: "monorepo/podinfo"},
+		{kind: fluxGitRepository, chartSpec: "./apps/web/", expected: "monorepo/gitrepositories/apps/web"},
+		{kind: fluxGitRepository, chartSpec: "legacy/web", expected: "monorepo/gitrepositories/legacy/web"},
+		{kind: fluxBucket, chartSpec: "/", expected: "monorepo/buckets/."},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			chartID := chartIDFromSourceRef(tc.kind, "monorepo", tc.chartSpec)
+			if got, want := chartID, tc.expected; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			kind, sourceName, _, err := sourceRefFromChartID(chartID)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := kind, tc.kind; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+			if got, want := sourceName, "monorepo"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+
+	if _, _, _, err := sourceRefFromChartID("monorepo/ocirepositories/apps/web"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got: %v, want: %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestChartsFromArtifactSizeLimits(t *testing.T) {
+	repo := &models.Repo{Namespace: "default", Name: "monorepo", Type: "git"}
+
+	t.Run("a chart with an oversized Chart.yaml is skipped", func(t *testing.T) {
+		artifact := newArtifactTarball(t, map[string]string{
+			"apps/web/Chart.yaml":  "apiVersion: v2\nname: webapp\nversion: 0.1.0\n",
+			"apps/huge/Chart.yaml": "apiVersion: v2\nname: huge\nversion: 0.1.0\ndescription: " + strings.Repeat("x", maxChartYamlSize) + "\n",
+		})
+		charts, err := chartsFromArtifact(bytes.NewReader(artifact), repo)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := len(charts), 1; got != want {
+			t.Fatalf("got: %d, want: %d", got, want)
+		}
+		if got, want := charts[0].ID, "monorepo/gitrepositories/apps/web"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+	})
+
+	t.Run("reading past the maximum size of an artifact fails", func(t *testing.T) {
+		reader := newSizeLimitedReader(strings.NewReader("0123456789"), 5)
+		if _, err := ioutil.ReadAll(reader); err == nil {
+			t.Errorf("expected an error reading 10 bytes with a limit of 5")
+		}
+		reader = newSizeLimitedReader(strings.NewReader("0123456789"), 10)
+		if contents, err := ioutil.ReadAll(reader); err != nil {
+			t.Errorf("%+v", err)
+		} else if got, want := string(contents), "0123456789"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+	})
+}
+
+func TestGetAvailablePackagesFromArtifactSource(t *testing.T) {
+	ts, source := newGitRepositoryWithArtifact(t, "monorepo", "default", monorepoFiles)
+	defer ts.Close()
+
+	s, mock, _, err := newServerWithRepos()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// the artifact cache shares the redis client of the HelmRepositories cache
+	helmCache := s.caches[kubeappsCluster]
+	gitSource, _ := artifactSourceForKind(fluxGitRepository)
+	gitCache := &NamespacedResourceWatcherCache{
+		config: cacheConfig{
+			gvr:     gitSource.gvr(),
+			cluster: kubeappsCluster,
+			onGet:   onGetRepo,
+		},
+		redisCli: helmCache.redisCli,
+	}
+	s.artifactCaches = map[string][]*NamespacedResourceWatcherCache{kubeappsCluster: {gitCache}}
+
+	name := types.NamespacedName{Namespace: "default", Name: "monorepo"}
+	gitKey := gitCache.keyForNamespacedName(name)
+	bytes, _, err := onAddOrModifyArtifactSource(gitKey, source.Object)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	t.Run("summaries include charts from the artifact", func(t *testing.T) {
+		mock.ExpectScan(0, fluxHelmRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{}, 0)
+		mock.ExpectScan(0, fluxGitRepositories+":"+kubeappsCluster+":*", 0).SetVal([]string{gitKey}, 0)
+		mock.ExpectGet(gitKey).SetVal(string(bytes.([]byte)))
+
+		response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expectedSummaries := []*corev1.AvailablePackageSummary{
+			{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "monorepo/gitrepositories/apps/web",
+					Context:    &corev1.Context{Namespace: "default"},
+					Plugin:     fluxPlugin,
+				},
+				DisplayName:   "webapp",
+				LatestVersion: &corev1.PackageAppVersion{PkgVersion: "0.1.0"},
+			},
+			{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "monorepo/gitrepositories/charts/podinfo",
+					Context:    &corev1.Context{Namespace: "default"},
+					Plugin:     fluxPlugin,
+				},
+				DisplayName:      "podinfo",
+				LatestVersion:    &corev1.PackageAppVersion{PkgVersion: "6.0.0", AppVersion: "6.0.0"},
+				ShortDescription: "Podinfo Helm chart for Kubernetes",
+			},
+			{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Identifier: "monorepo/gitrepositories/legacy/web",
+					Context:    &corev1.Context{Namespace: "default"},
+					Plugin:     fluxPlugin,
+				},
+				DisplayName:   "web",
+				LatestVersion: &corev1.PackageAppVersion{PkgVersion: "0.0.1"},
+			},
+		}
+		opts := cmpopts.IgnoreUnexported(corev1.AvailablePackageSummary{}, corev1.AvailablePackageReference{}, corev1.Context{}, plugins.Plugin{}, corev1.PackageAppVersion{})
+		if got, want := response.AvailablePackageSummaries, expectedSummaries; !cmp.Equal(got, want, opts) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%v", err)
+		}
+	})
+
+	t.Run("versions are looked up in the cache of the kind of source only", func(t *testing.T) {
+		mock.ExpectGet(gitKey).SetVal(string(bytes.([]byte)))
+
+		response, err := s.GetAvailablePackageVersions(context.Background(), &corev1.GetAvailablePackageVersionsRequest{
+			AvailablePackageRef: &corev1.AvailablePackageReference{
+				Identifier: "monorepo%2Fgitrepositories%2Flegacy%2Fweb",
+				Context:    &corev1.Context{Namespace: "default"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := response.PackageAppVersions[0].PkgVersion, "0.0.1"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%v", err)
+		}
+	})
+
+	t.Run("the detail of a missing chart from the artifact is not found", func(t *testing.T) {
+		mock.ExpectGet(gitKey).SetVal(string(bytes.([]byte)))
+
+		_, err := s.GetAvailablePackageDetail(context.Background(), &corev1.GetAvailablePackageDetailRequest{
+			AvailablePackageRef: &corev1.AvailablePackageReference{
+				Identifier: "monorepo/gitrepositories/web",
+				Context:    &corev1.Context{Namespace: "default"},
+			},
+		})
+		if got, want := status.Code(err), codes.NotFound; got != want {
+			t.Errorf("got: %v, want: %v", got, want)
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%v", err)
+		}
+	})
+
+	t.Run("a release of a chart from the artifact refers to the chart by its path", func(t *testing.T) {
+		mock.ExpectGet(gitKey).SetVal(string(bytes.([]byte)))
+
+		chart, err := s.fetchChartFromCache("", fluxGitRepository, name, "apps/web")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		} else if chart == nil {
+			t.Fatalf("chart [monorepo/gitrepositories/apps/web] not found in cache")
+		}
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		release, err := newFluxHelmRelease(chart, "kubeapps", types.NamespacedName{Namespace: "test", Name: "my-web"}, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expectedChartSpec := map[string]interface{}{
+			"chart": "apps/web",
+			"sourceRef": map[string]interface{}{
+				"name":      "monorepo",
+				"kind":      fluxGitRepository,
+				"namespace": "default",
+			},
+		}
+		chartSpec, _, _ := unstructured.NestedMap(release.Object, "spec", "chart", "spec")
+		if got, want := chartSpec, expectedChartSpec; !cmp.Equal(got, want) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+		}
+
+		// and the installed package refers back to the same available package
+		ref, err := installedPackageAvailablePackageRefFromUnstructured(release.Object)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if got, want := ref.Identifier, "monorepo/gitrepositories/apps/web"; got != want {
+			t.Errorf("got: %q, want: %q", got, want)
+		}
+	})
+}
+
+// newGitRepositoryWithArtifact returns a ready GitRepository whose artifact,
+// containing the given files, is served by the returned test server.
+func newGitRepositoryWithArtifact(t *testing.T, name, namespace string, files map[string]string) (*httptest.Server, *unstructured.Unstructured) {
+	artifact := newArtifactTarball(t, files)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write(artifact)
+	}))
+
+	spec := map[string]interface{}{
+		"url":      "https://github.com/example/monorepo",
+		"interval": "1m0s",
+	}
+	status := map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":   "Ready",
+				"status": "True",
+				"reason": "GitOperationSucceed",
+			},
+		},
+		"artifact": map[string]interface{}{
+			"url": fmt.Sprintf("%s/gitrepository/%s/%s/latest.tar.gz", ts.URL, namespace, name),
+		},
+	}
+	source := newRepo(name, namespace, spec, status)
+	source.SetKind(fluxGitRepository)
+	return ts, source
+}
+
+func newArtifactTarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range files {
+		header := &tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := tarWriter.Write([]byte(contents)); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return buf.Bytes()
+}
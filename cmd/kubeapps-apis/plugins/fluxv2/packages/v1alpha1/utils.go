@@ -70,14 +70,11 @@ func prettyPrintMap(m map[string]interface{}) string {
 }
 
 // pageOffsetFromPageToken converts a page token to an integer offset
-// representing the page of results.
-// TODO(gfichtenholt): it'd be better if we ensure that the page_token
-// contains an offset to the item, not the page so we can
-// aggregate paginated results. Same as helm hlug-in.
-// Update this when helm plug-in does so
+// representing the next item of the results, so that the core server can
+// aggregate the paginated results of several plugins. Same as helm plug-in.
 func pageOffsetFromPageToken(pageToken string) (int, error) {
 	if pageToken == "" {
-		return 1, nil
+		return 0, nil
 	}
 	offset, err := strconv.ParseUint(pageToken, 10, 0)
 	if err != nil {
@@ -87,16 +84,16 @@ func pageOffsetFromPageToken(pageToken string) (int, error) {
 }
 
 // getUnescapedChartID takes a chart id with URI-encoded characters and decode them. Ex: 'foo%2Fbar' becomes 'foo/bar'
-// also checks that the chart ID is in the expected format, namely "repoName/chartName"
+// also checks that the chart ID is in the expected format, namely "repoName/chartName" for the charts of
+// HelmRepositories or "sourceName/resource/chartPath" for those of GitRepositories and Buckets
 func getUnescapedChartID(chartID string) (string, error) {
 	unescapedChartID, err := url.QueryUnescape(chartID)
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Unable to decode chart ID chart: %v", chartID)
 	}
-	// TODO(agamez): support ID with multiple slashes, eg: aaa/bbb/ccc
 	chartIDParts := strings.Split(unescapedChartID, "/")
-	if len(chartIDParts) != 2 {
-		return "", status.Errorf(codes.InvalidArgument, "Incorrect request.AvailablePackageRef.Identifier, currently just 'foo/bar' patters are supported: %s", chartID)
+	if len(chartIDParts) < 2 {
+		return "", status.Errorf(codes.InvalidArgument, "Incorrect request.AvailablePackageRef.Identifier, currently just 'foo/bar' or 'foo/resource/path/to/bar' patterns are supported: %s", chartID)
 	}
 	return unescapedChartID, nil
 }
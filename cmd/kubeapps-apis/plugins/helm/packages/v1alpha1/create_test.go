@@ -15,24 +15,43 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/kubeapps/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/pkg/chart/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
+	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	typfake "k8s.io/client-go/kubernetes/fake"
 )
 
 func TestCreateInstalledPackage(t *testing.T) {
+	chartJSON, err := json.Marshal(&models.Chart{
+		ID:            "bitnami/apache",
+		Name:          "apache",
+		Repo:          &models.Repo{Name: "bitnami", Namespace: globalPackagingNamespace},
+		ChartVersions: []models.ChartVersion{{Version: "1.19.0"}, {Version: "1.18.4"}, {Version: "1.18.3"}},
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
 	testCases := []struct {
 		name               string
 		request            *corev1.CreateInstalledPackageRequest
+		expectQueries      func(mock sqlmock.Sqlmock)
 		expectedResponse   *corev1.CreateInstalledPackageResponse
 		expectedStatusCode codes.Code
 		expectedRelease    *release.Release
@@ -73,14 +92,120 @@ func TestCreateInstalledPackage(t *testing.T) {
 					Status:      release.StatusDeployed,
 				},
 				Chart: &chart.Chart{
-					Metadata: &chart.Metadata{Name: "apache"},
-					Values:   map[string]interface{}{},
+					Metadata: &chart.Metadata{
+						Name: "apache",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: globalPackagingNamespace,
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+						},
+					},
+					Values: map[string]interface{}{},
 				},
 				Config:    map[string]interface{}{"foo": "bar"},
 				Version:   1,
 				Namespace: "default",
 			},
 		},
+		{
+			name: "creates the installed package with the newest version satisfying a constraint",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "~1.18",
+				},
+			},
+			expectQueries: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT info FROM charts WHERE repo_namespace = \\$1 AND chart_id = \\$2").
+					WithArgs(globalPackagingNamespace, "bitnami/apache").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(string(chartJSON)))
+			},
+			expectedResponse: &corev1.CreateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRelease: &release.Release{
+				Name: "my-apache",
+				Info: &release.Info{
+					Description: "Install complete",
+					Status:      release.StatusDeployed,
+				},
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name: "apache",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: globalPackagingNamespace,
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+							VersionConstraintAnnotation:      "~1.18",
+						},
+					},
+					Values: map[string]interface{}{},
+				},
+				Config:    map[string]interface{}{},
+				Version:   1,
+				Namespace: "default",
+			},
+		},
+		{
+			name: "returns not found if no version satisfies the constraint",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "^2.0",
+				},
+			},
+			expectQueries: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT info FROM charts WHERE repo_namespace = \\$1 AND chart_id = \\$2").
+					WithArgs(globalPackagingNamespace, "bitnami/apache").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(string(chartJSON)))
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if the version is not a semver constraint",
+			request: &corev1.CreateInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "not-a-version",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
 		{
 			name: "returns invalid if available package ref invalid",
 			request: &corev1.CreateInstalledPackageRequest{
@@ -107,13 +232,16 @@ func TestCreateInstalledPackage(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			authorized := true
 			actionConfig := newActionConfigFixture(t, tc.request.GetTargetContext().GetNamespace(), nil)
-			server, _, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+			server, mock, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "bitnami",
 					Namespace: globalPackagingNamespace,
 				},
 			})
 			defer cleanup()
+			if tc.expectQueries != nil {
+				tc.expectQueries(mock)
+			}
 
 			response, err := server.CreateInstalledPackage(context.Background(), tc.request)
 
@@ -141,6 +269,262 @@ func TestCreateInstalledPackage(t *testing.T) {
 					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, ignoredFields))
 				}
 			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateValuesAgainstSchema(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"required": ["image"],
+		"properties": {
+			"replicaCount": {"type": "integer"},
+			"image": {
+				"type": "object",
+				"required": ["tag"],
+				"properties": {"tag": {"type": "string"}}
+			}
+		}
+	}`)
+	subchartSchema := []byte(`{
+		"type": "object",
+		"properties": {"enabled": {"type": "boolean"}}
+	}`)
+	newChart := func() *chart.Chart {
+		ch := &chart.Chart{
+			Metadata: &chart.Metadata{Name: "apache"},
+			Values: map[string]interface{}{
+				"image": map[string]interface{}{"tag": "2.4"},
+			},
+			Schema: schema,
+		}
+		ch.AddDependency(&chart.Chart{
+			Metadata: &chart.Metadata{Name: "common"},
+			Schema:   subchartSchema,
+		})
+		return ch
+	}
+
+	testCases := []struct {
+		name               string
+		values             string
+		expectedStatusCode codes.Code
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:               "accepts values matching the schema",
+			values:             "replicaCount: 2",
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "accepts the chart defaults",
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:               "returns the violations of the chart schema",
+			values:             "replicaCount: two",
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "replicaCount",
+					Description: "Invalid type. Expected: integer, given: string",
+				},
+			},
+		},
+		{
+			name:               "returns the path of missing required fields",
+			values:             "image: {tag: null}",
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "image.tag",
+					Description: "tag is required",
+				},
+			},
+		},
+		{
+			name:               "returns the violations of the dependency schemas",
+			values:             "common: {enabled: yes-please}",
+			expectedStatusCode: codes.InvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       "common.enabled",
+					Description: "Invalid type. Expected: boolean, given: string",
+				},
+			},
+		},
+		{
+			name:               "returns invalid if the values cannot be parsed",
+			values:             "not: [valid",
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateValuesAgainstSchema(newChart(), tc.values)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedViolations == nil {
+				return
+			}
+			details := status.Convert(err).Details()
+			if got, want := len(details), 1; got != want {
+				t.Fatalf("got: %d, want: %d", got, want)
+			}
+			badRequest, ok := details[0].(*errdetails.BadRequest)
+			if !ok {
+				t.Fatalf("got: %T, want: *errdetails.BadRequest", details[0])
+			}
+			if got, want := badRequest.GetFieldViolations(), tc.expectedViolations; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestValidateValuesAgainstSchemaKeepsTheChart(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{
+			Name: "apache",
+			Dependencies: []*chart.Dependency{
+				{Name: "common", Condition: "common.enabled"},
+			},
+		},
+	}
+	ch.AddDependency(&chart.Chart{Metadata: &chart.Metadata{Name: "common"}})
+
+	if err := validateValuesAgainstSchema(ch, "common: {enabled: false}"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// The disabled dependency is only removed when the chart is installed.
+	if got, want := len(ch.Dependencies()), 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+	if got, want := len(ch.Metadata.Dependencies), 1; got != want {
+		t.Fatalf("got: %d, want: %d", got, want)
+	}
+	if got, want := ch.Metadata.Dependencies[0].Enabled, false; got != want {
+		t.Errorf("got: %t, want: %t", got, want)
+	}
+}
+
+func TestValuesFromSources(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{
+			Name: "apache",
+			Annotations: map[string]string{
+				AppRepositoryNamespaceAnnotation: globalPackagingNamespace,
+				AppRepositoryNameAnnotation:      "bitnami",
+			},
+		},
+	}
+	objects := []runtime.Object{
+		&k8scorev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "base-values", Namespace: "default"},
+			Data: map[string]string{
+				"values.yaml": "replicaCount: 2\nimage:\n  tag: \"2.4\"\n  pullPolicy: Always\n",
+			},
+		},
+		&k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("auth:\n  password: secret\n"),
+			},
+		},
+		&k8scorev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "apprepo-bitnami-presets", Namespace: globalPackagingNamespace},
+			Data: map[string]string{
+				"production": "replicaCount: 3\nimage:\n  pullPolicy: IfNotPresent\n",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		sources            []*corev1.ValueSource
+		values             string
+		expectedStatusCode codes.Code
+		expectedValues     string
+	}{
+		{
+			name:               "returns the values unchanged without sources",
+			values:             "replicaCount: 1 # a comment",
+			expectedStatusCode: codes.OK,
+			expectedValues:     "replicaCount: 1 # a comment",
+		},
+		{
+			name: "deep-merges the sources in order and the values on top",
+			sources: []*corev1.ValueSource{
+				{Source: &corev1.ValueSource_ConfigMapKeyRef{ConfigMapKeyRef: &corev1.ValueSourceKeyRef{Name: "base-values", Key: "values.yaml"}}},
+				{Source: &corev1.ValueSource_Preset{Preset: "production"}},
+				{Source: &corev1.ValueSource_SecretKeyRef{SecretKeyRef: &corev1.ValueSourceKeyRef{Name: "credentials", Key: "values.yaml"}}},
+				{Source: &corev1.ValueSource_Inline{Inline: "image:\n  tag: \"2.5\"\n"}},
+			},
+			values:             "replicaCount: 4",
+			expectedStatusCode: codes.OK,
+			expectedValues:     "auth:\n  password: secret\nimage:\n  pullPolicy: IfNotPresent\n  tag: \"2.5\"\nreplicaCount: 4\n",
+		},
+		{
+			name: "returns not found for a missing ConfigMap",
+			sources: []*corev1.ValueSource{
+				{Source: &corev1.ValueSource_ConfigMapKeyRef{ConfigMapKeyRef: &corev1.ValueSourceKeyRef{Name: "other-values", Key: "values.yaml"}}},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns not found for a missing key",
+			sources: []*corev1.ValueSource{
+				{Source: &corev1.ValueSource_SecretKeyRef{SecretKeyRef: &corev1.ValueSourceKeyRef{Name: "credentials", Key: "other.yaml"}}},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns not found for a missing preset",
+			sources: []*corev1.ValueSource{
+				{Source: &corev1.ValueSource_Preset{Preset: "staging"}},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid for values which cannot be parsed",
+			sources: []*corev1.ValueSource{
+				{Source: &corev1.ValueSource_Inline{Inline: "not: [valid"}},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, _, cleanup := makeServer(t, true, nil)
+			defer cleanup()
+			typedClient, _, err := server.GetClients(context.Background(), globalPackagingCluster)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			for _, obj := range objects {
+				if err = typedClient.(*typfake.Clientset).Tracker().Add(obj); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			values, err := server.valuesFromSources(context.Background(), globalPackagingCluster, "default", ch, tc.sources, tc.values)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if got, want := values, tc.expectedValues; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
 		})
 	}
 }
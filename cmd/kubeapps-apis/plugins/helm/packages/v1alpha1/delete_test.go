@@ -0,0 +1,135 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+func TestDeleteInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.DeleteInstalledPackageRequest
+		expectedStatusCode codes.Code
+		expectedHistory    bool
+	}{
+		{
+			name: "deletes the installed package",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+				},
+			},
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "deletes the installed package keeping the history",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+				},
+			},
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				KeepHistory: true,
+			},
+			expectedStatusCode: codes.OK,
+			expectedHistory:    true,
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			_, err := server.DeleteInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			// Verify the release was uninstalled, with or without history.
+			rel, err := actionConfig.Releases.Last(tc.request.GetInstalledPackageRef().GetIdentifier())
+			if tc.expectedHistory {
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := rel.Info.Status, release.StatusUninstalled; got != want {
+					t.Errorf("got: %q, want: %q", got, want)
+				}
+			} else if err != driver.ErrReleaseNotFound {
+				t.Errorf("got: %+v, want: %+v", err, driver.ErrReleaseNotFound)
+			}
+		})
+	}
+}
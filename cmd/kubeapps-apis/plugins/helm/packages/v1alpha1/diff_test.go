@@ -0,0 +1,151 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubeapps/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiffInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.DiffInstalledPackageRequest
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.DiffInstalledPackageResponse
+	}{
+		{
+			name: "returns the resources removed by the upgrade",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+					manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-apache
+`,
+				},
+			},
+			request: &corev1.DiffInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+				Values: "{\"foo\": \"baz\"}",
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.DiffInstalledPackageResponse{
+				ResourceDiffs: []*corev1.ResourceDiff{
+					{
+						ResourceRef: &corev1.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "my-apache",
+							Namespace:  "default",
+						},
+						DiffType: corev1.ResourceDiff_DIFF_TYPE_REMOVED,
+						Diff: `--- current
++++ target
+@@ -1,4 +0,0 @@
+-apiVersion: v1
+-kind: ConfigMap
+-metadata:
+-  name: my-apache
+`,
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.DiffInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &corev1.DiffInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, mock, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: "default",
+				},
+			})
+			defer cleanup()
+
+			if tc.expectedStatusCode == codes.OK {
+				populateAssetDB(t, mock, []*releaseStub{&tc.existingReleases[0]})
+			}
+
+			response, err := server.DiffInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+
+			// Diffing must not upgrade the release.
+			if tc.expectedStatusCode == codes.OK {
+				rel, err := actionConfig.Releases.Last("my-apache")
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := rel.Version, 1; got != want {
+					t.Errorf("got: %d, want: %d", got, want)
+				}
+			}
+		})
+	}
+}
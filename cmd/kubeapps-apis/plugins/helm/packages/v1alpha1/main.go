@@ -42,8 +42,12 @@ func init() {
 
 // RegisterWithGRPCServer enables a plugin to register with a gRPC server
 // returning the server implementation.
-func RegisterWithGRPCServer(s grpc.ServiceRegistrar, configGetter server.KubernetesConfigGetter, clustersConfig kube.ClustersConfig) (interface{}, error) {
-	svr := NewServer(configGetter, clustersConfig.KubeappsClusterName)
+func RegisterWithGRPCServer(s grpc.ServiceRegistrar, configGetter server.KubernetesConfigGetter, clustersConfig kube.ClustersConfig, pluginsConfig server.PluginsConfig) (interface{}, error) {
+	storageForDriver, err := pluginsConfig.Helm.StorageForDriver(clustersConfig)
+	if err != nil {
+		return nil, err
+	}
+	svr := NewServer(configGetter, clustersConfig.KubeappsClusterName, pluginsConfig.Helm.StorageDriver, storageForDriver)
 	v1alpha1.RegisterHelmPackagesServiceServer(s, svr)
 	return svr, nil
 }
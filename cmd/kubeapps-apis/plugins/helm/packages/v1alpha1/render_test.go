@@ -0,0 +1,116 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeapps/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRenderInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.RenderInstalledPackageRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name: "renders the installed package without creating a release",
+			request: &corev1.RenderInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.3",
+				},
+				Values: "{\"foo\": \"bar\"}",
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "returns invalid if the values cannot be parsed",
+			request: &corev1.RenderInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &corev1.Context{
+					Namespace: "default",
+				},
+				Name: "my-apache",
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.3",
+				},
+				Values: "not: [valid",
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name: "returns invalid if available package ref invalid",
+			request: &corev1.RenderInstalledPackageRequest{
+				AvailablePackageRef: &corev1.AvailablePackageReference{
+					Context: &corev1.Context{
+						Namespace: globalPackagingNamespace,
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetTargetContext().GetNamespace(), nil)
+			server, _, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: globalPackagingNamespace,
+				},
+			})
+			defer cleanup()
+
+			_, err := server.RenderInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// Rendering must not store a release.
+			releases, err := actionConfig.Releases.Driver.List(func(*release.Release) bool { return true })
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := len(releases), 0; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+		})
+	}
+}
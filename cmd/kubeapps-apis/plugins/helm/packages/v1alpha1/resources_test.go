@@ -0,0 +1,238 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const apacheManifest = `
+---
+# Source: apache/templates/svc.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-apache
+---
+# Source: apache/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-apache
+  namespace: default
+spec:
+  replicas: 2
+---
+# Source: apache/templates/clusterrole.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: my-apache
+`
+
+func apacheReleaseStubs() []releaseStub {
+	return []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      1,
+			manifest:     apacheManifest,
+		},
+	}
+}
+
+func TestGetInstalledPackageResourceRefs(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.GetInstalledPackageResourceRefsRequest
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.GetInstalledPackageResourceRefsResponse
+	}{
+		{
+			name:             "returns the resource refs from the release manifest",
+			existingReleases: apacheReleaseStubs(),
+			request: &corev1.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageResourceRefsResponse{
+				ResourceRefs: []*corev1.ResourceRef{
+					{ApiVersion: "v1", Kind: "Service", Name: "my-apache", Namespace: "default"},
+					{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-apache", Namespace: "default"},
+					{ApiVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "my-apache"},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &corev1.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageResourceRefs(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageResourceStatuses(t *testing.T) {
+	deployment := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "my-apache",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+			},
+			"status": map[string]interface{}{
+				"updatedReplicas":   int64(2),
+				"availableReplicas": int64(1),
+			},
+		},
+	}
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":      "my-apache",
+				"namespace": "default",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		existingObjects    []runtime.Object
+		request            *corev1.GetInstalledPackageResourceStatusesRequest
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.GetInstalledPackageResourceStatusesResponse
+	}{
+		{
+			name:             "returns the readiness of the release resources",
+			existingReleases: apacheReleaseStubs(),
+			existingObjects:  []runtime.Object{deployment, service},
+			request: &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageResourceStatusesResponse{
+				ResourceStatuses: []*corev1.ResourceStatus{
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-apache", Namespace: "default"},
+						Ready:       true,
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-apache", Namespace: "default"},
+						Reason:      "1/2 replicas ready",
+					},
+					{
+						ResourceRef: &corev1.ResourceRef{ApiVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "my-apache"},
+						Reason:      "Not found",
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, _, cleanup := makeServer(t, authorized, actionConfig, tc.existingObjects...)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageResourceStatuses(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func apacheHistoryStubs() []releaseStub {
+	return []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.2",
+			status:       release.StatusSuperseded,
+			version:      1,
+		},
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      2,
+		},
+	}
+}
+
+func TestGetInstalledPackageRevisions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.GetInstalledPackageRevisionsRequest
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.GetInstalledPackageRevisionsResponse
+	}{
+		{
+			name:             "returns the revisions from the latest to the oldest",
+			existingReleases: apacheHistoryStubs(),
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageRevisionsResponse{
+				Revisions: []*corev1.InstalledPackageRevision{
+					{
+						Revision: 2,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.3",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Ready:      true,
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+							UserReason: "deployed",
+						},
+					},
+					{
+						Revision: 1,
+						Version: &corev1.PackageAppVersion{
+							PkgVersion: "1.18.2",
+							AppVersion: DefaultAppVersion,
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "superseded",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &corev1.GetInstalledPackageRevisionsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			response, err := server.GetInstalledPackageRevisions(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.RollbackInstalledPackageRequest
+		expectedStatusCode codes.Code
+	}{
+		{
+			name:             "rolls back the installed package",
+			existingReleases: apacheHistoryStubs(),
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				Revision: 1,
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name:             "returns not found if the revision doesn't exist",
+			existingReleases: apacheHistoryStubs(),
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				Revision: 5,
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name:             "returns invalid if the revision is missing",
+			existingReleases: apacheHistoryStubs(),
+			request: &corev1.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			response, err := server.RollbackInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			expectedRef := &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Cluster:   "default",
+					Namespace: "default",
+				},
+				Identifier: "my-apache",
+				Plugin:     GetPluginDetail(),
+			}
+			if got, want := response.InstalledPackageRef, expectedRef; !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform()))
+			}
+
+			// Verify a new revision was created with the chart of the
+			// revision rolled back to.
+			rel, err := actionConfig.Releases.Last("my-apache")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := rel.Version, 3; got != want {
+				t.Errorf("got: %d, want: %d", got, want)
+			}
+			if got, want := rel.Chart.Metadata.Version, "1.18.2"; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
@@ -14,12 +14,19 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/kubeapps/common/datastore"
@@ -33,21 +40,31 @@ import (
 	"github.com/kubeapps/kubeapps/pkg/chart"
 	"github.com/kubeapps/kubeapps/pkg/chart/models"
 	"github.com/kubeapps/kubeapps/pkg/handlerutil"
+	"github.com/kubeapps/kubeapps/pkg/helm"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"helm.sh/helm/v3/pkg/action"
+	helmchart "helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/kube"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corek8sv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
 type clientGetter func(context.Context, string) (kubernetes.Interface, dynamic.Interface, error)
@@ -63,6 +80,17 @@ const (
 	UserAgentPrefix        = "kubeapps-apis/plugins"
 )
 
+// Annotations recorded on the chart of the releases created or upgraded by
+// this plugin, to reference the chart from which they were installed.
+const (
+	AppRepositoryNamespaceAnnotation = "kubeapps.com/apprepository-namespace"
+	AppRepositoryNameAnnotation      = "kubeapps.com/apprepository-name"
+	ChartIDAnnotation                = "kubeapps.com/chart-id"
+	// VersionConstraintAnnotation records the semver constraint, such as
+	// "~1.4", requested for the version of the release, if any.
+	VersionConstraintAnnotation = "kubeapps.com/version-constraint"
+)
+
 // Server implements the helm packages v1alpha1 interface.
 type Server struct {
 	v1alpha1.UnimplementedHelmPackagesServiceServer
@@ -75,11 +103,14 @@ type Server struct {
 	manager                  utils.AssetManager
 	actionConfigGetter       helmActionConfigGetter
 	chartClientFactory       chart.ChartClientFactoryInterface
+	// storageDriver is the configured storage of the Helm releases, which
+	// defaults to secrets when empty.
+	storageDriver string
 }
 
 // NewServer returns a Server automatically configured with a function to obtain
 // the k8s client config.
-func NewServer(configGetter server.KubernetesConfigGetter, globalPackagingCluster string) *Server {
+func NewServer(configGetter server.KubernetesConfigGetter, globalPackagingCluster string, storageDriver string, storageForDriver agent.StorageForDriver) *Server {
 	var kubeappsNamespace = os.Getenv("POD_NAMESPACE")
 	var ASSET_SYNCER_DB_URL = os.Getenv("ASSET_SYNCER_DB_URL")
 	var ASSET_SYNCER_DB_NAME = os.Getenv("ASSET_SYNCER_DB_NAME")
@@ -130,8 +161,10 @@ func NewServer(configGetter server.KubernetesConfigGetter, globalPackagingCluste
 			if err != nil {
 				return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to create kubernetes client : %v", err))
 			}
-			// TODO(mnelson): Update to allow different helm storage options.
-			storage := agent.StorageForSecrets(namespace, clientSet)
+			storage, err := storageForDriver(namespace, clientSet)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, fmt.Sprintf("unable to create the storage of the Helm releases : %v", err))
+			}
 			return &action.Configuration{
 				RESTClientGetter: restClientGetter,
 				KubeClient:       kube.New(restClientGetter),
@@ -143,6 +176,7 @@ func NewServer(configGetter server.KubernetesConfigGetter, globalPackagingCluste
 		globalPackagingNamespace: kubeappsNamespace,
 		globalPackagingCluster:   globalPackagingCluster,
 		chartClientFactory:       &chart.ChartClientFactory{},
+		storageDriver:            storageDriver,
 	}
 }
 
@@ -183,26 +217,33 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		namespace = request.Context.Namespace
 		cluster = request.Context.Cluster
 	}
-	// Check the requested namespace: if any, return "everything a user can read";
-	// otherwise, first check if the user can access the requested ns
-	if namespace == "" {
-		// TODO(agamez): not including a namespace means that it returns everything a user can read
-		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: not including a namespace means that it returns everything a user can read")
-	}
-	// After requesting a specific namespace, we have to ensure the user can actually access to it
-	if err := s.hasAccessToNamespace(ctx, cluster, namespace); err != nil {
-		return nil, err
-	}
-
-	// If the request is for available packages on another cluster, we only
-	// return the global packages (ie. kubeapps namespace)
+	// Check the requested namespace: if any, first check if the user can
+	// access the requested ns; otherwise, return "everything a user can read"
+	var namespaces []string
 	if cluster != "" && cluster != s.globalPackagingCluster {
+		// If the request is for available packages on another cluster, we only
+		// return the global packages (ie. kubeapps namespace)
+		if namespace != "" {
+			if err := s.hasAccessToNamespace(ctx, cluster, namespace); err != nil {
+				return nil, err
+			}
+		}
 		namespace = s.globalPackagingNamespace
+	} else if namespace == "" {
+		var err error
+		namespaces, err = s.readableNamespaces(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := s.hasAccessToNamespace(ctx, cluster, namespace); err != nil {
+		// After requesting a specific namespace, we have to ensure the user can actually access to it
+		return nil, err
 	}
 
 	// Create the initial chart query with the namespace
 	cq := utils.ChartQuery{
-		Namespace: namespace,
+		Namespace:  namespace,
+		Namespaces: namespaces,
 	}
 
 	// Add any other filter if a FilterOptions is passed
@@ -222,7 +263,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 
 	// This plugin will include, as part of the GetAvailablePackageSummariesResponse,
 	// a "Categories" field containing only the distinct category names considering just the namespace
-	chartCategories, err := s.manager.GetAllChartCategories(utils.ChartQuery{Namespace: namespace})
+	chartCategories, err := s.manager.GetAllChartCategories(utils.ChartQuery{Namespace: namespace, Namespaces: namespaces})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to fetch chart categories: %v", err)
 	}
@@ -232,10 +273,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		categories = append(categories, cat.Name)
 	}
 
-	// The current assetsvc manager works on a page number (ie. 1 for the first page),
-	// rather than an offset.
-	pageNumber := pageOffset + 1
-	charts, _, err := s.manager.GetPaginatedChartListWithFilters(cq, pageNumber, int(pageSize))
+	charts, err := s.manager.GetChartListWithFilters(cq, chartListOptions(request.GetSortOptions(), pageOffset, int(pageSize)))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to retrieve charts: %v", err)
 	}
@@ -256,7 +294,7 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 	// the results are a full page.
 	nextPageToken := ""
 	if pageSize > 0 && len(responsePackages) == int(pageSize) {
-		nextPageToken = fmt.Sprintf("%d", pageOffset+1)
+		nextPageToken = fmt.Sprintf("%d", pageOffset+len(responsePackages))
 	}
 	return &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: responsePackages,
@@ -266,11 +304,8 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 }
 
 // pageOffsetFromPageToken converts a page token to an integer offset
-// representing the page of results.
-// TODO(mnelson): When aggregating results from different plugins, we'll
-// need to update the actual query in GetPaginatedChartListWithFilters to
-// use a row offset rather than a page offset (as not all rows may be consumed
-// for a specific plugin when combining).
+// representing the first item of the requested page of results, so that the
+// core API can merge the results of this plugin with those of other plugins.
 func pageOffsetFromPageToken(pageToken string) (int, error) {
 	if pageToken == "" {
 		return 0, nil
@@ -283,6 +318,25 @@ func pageOffsetFromPageToken(pageToken string) (int, error) {
 	return int(offset), nil
 }
 
+// chartListOptions returns the assetsvc options for the requested order and
+// window of charts.
+func chartListOptions(sortOptions *corev1.SortOptions, offset, limit int) utils.ChartListOptions {
+	opts := utils.ChartListOptions{
+		SortDescending: sortOptions.GetDescending(),
+		Offset:         offset,
+		Limit:          limit,
+	}
+	switch sortOptions.GetField() {
+	case corev1.SortOptions_SORT_FIELD_LATEST_VERSION:
+		opts.SortField = utils.ChartSortByLatestVersion
+	case corev1.SortOptions_SORT_FIELD_REPOSITORY:
+		opts.SortField = utils.ChartSortByRepository
+	default:
+		opts.SortField = utils.ChartSortByName
+	}
+	return opts
+}
+
 // AvailablePackageSummaryFromChart builds an AvailablePackageSummary from a Chart
 func AvailablePackageSummaryFromChart(chart *models.Chart) (*corev1.AvailablePackageSummary, error) {
 	pkg := &corev1.AvailablePackageSummary{}
@@ -538,26 +592,207 @@ func (s *Server) hasAccessToNamespace(ctx context.Context, cluster, namespace st
 		return err
 	}
 
-	res, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(context.TODO(), &authorizationv1.SelfSubjectAccessReview{
-		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Group:     "",
-				Resource:  "secrets",
-				Verb:      "get",
-				Namespace: namespace,
-			},
-		},
-	}, metav1.CreateOptions{})
+	allowed, err := isAllowed(ctx, client, &authorizationv1.ResourceAttributes{
+		Group:     "",
+		Resource:  "secrets",
+		Verb:      "get",
+		Namespace: namespace,
+	})
 	if err != nil {
 		return status.Errorf(codes.Internal, "Unable to check if the user has access to the namespace: %s", err)
 	}
-	if !res.Status.Allowed {
+	if !allowed {
 		// If the user has not access, return a unauthenticated response, otherwise, continue
 		return status.Errorf(codes.Unauthenticated, "The current user has no access to the namespace %q", namespace)
 	}
 	return nil
 }
 
+// checkReleasesAccess ensures that the user can read the Helm releases of a
+// namespace. The releases stored in the cluster are read with the credentials
+// of the user, but those stored with the sql driver are read with the
+// credentials of the server, so the RBAC of the cluster has to be checked
+// explicitly, requiring the same access as the secrets driver.
+func (s *Server) checkReleasesAccess(ctx context.Context, cluster, namespace string) error {
+	if s.storageDriver != "sql" {
+		return nil
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	client, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := isAllowed(ctx, client, releasesResourceAttributes(namespace))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to check if the user has access to the releases of the namespace: %s", err)
+	}
+	if !allowed {
+		return status.Errorf(codes.Unauthenticated, "The current user has no access to the releases of the namespace %q", namespace)
+	}
+	return nil
+}
+
+// releasesReadableNamespaces returns the namespaces in which the user can
+// read the Helm releases stored with the sql driver (see checkReleasesAccess).
+func (s *Server) releasesReadableNamespaces(ctx context.Context, cluster string) (sets.String, error) {
+	client, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceList, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsForbidden(err) {
+			// Without being able to list the namespaces, the user
+			// cannot list the releases of every namespace.
+			return nil, status.Errorf(codes.Unauthenticated, "The current user has no access to the releases of every namespace: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to list the namespaces: %v", err)
+	}
+	candidates := []string{}
+	for _, ns := range namespaceList.Items {
+		candidates = append(candidates, ns.Name)
+	}
+
+	allowed, err := filterAllowedNamespaces(ctx, client, candidates, releasesResourceAttributes)
+	if err != nil {
+		return nil, err
+	}
+	return sets.NewString(allowed...), nil
+}
+
+// releasesResourceAttributes returns the access required to read the Helm
+// releases of a namespace.
+func releasesResourceAttributes(namespace string) *authorizationv1.ResourceAttributes {
+	return &authorizationv1.ResourceAttributes{
+		Group:     "",
+		Resource:  "secrets",
+		Verb:      "get",
+		Namespace: namespace,
+	}
+}
+
+// readableNamespaces returns the global namespace together with every
+// namespace in which the user can list AppRepositories.
+func (s *Server) readableNamespaces(ctx context.Context, cluster string) ([]string, error) {
+	client, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceList, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsForbidden(err) {
+			// Without being able to list the namespaces, the user can
+			// only read the global packages.
+			return []string{s.globalPackagingNamespace}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to list the namespaces: %v", err)
+	}
+	candidates := []string{}
+	for _, ns := range namespaceList.Items {
+		if ns.Name != s.globalPackagingNamespace && ns.Status.Phase != corek8sv1.NamespaceTerminating {
+			candidates = append(candidates, ns.Name)
+		}
+	}
+
+	allowed, err := filterAllowedNamespaces(ctx, client, candidates, func(namespace string) *authorizationv1.ResourceAttributes {
+		return &authorizationv1.ResourceAttributes{
+			Group:     "kubeapps.com",
+			Resource:  "apprepositories",
+			Verb:      "list",
+			Namespace: namespace,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{s.globalPackagingNamespace}, allowed...), nil
+}
+
+// maxAccessReviewWorkers is the maximum number of SelfSubjectAccessReviews
+// created concurrently when checking the access to many namespaces.
+const maxAccessReviewWorkers = 10
+
+type namespaceAccessResult struct {
+	namespace string
+	allowed   bool
+	err       error
+}
+
+// filterAllowedNamespaces returns, sorted, the namespaces in which the user is
+// allowed the resource attributes returned for each namespace. It fails when
+// the access to any of the namespaces cannot be checked, rather than returning
+// an incomplete list.
+func filterAllowedNamespaces(ctx context.Context, client kubernetes.Interface, namespaces []string, attributesForNamespace func(string) *authorizationv1.ResourceAttributes) ([]string, error) {
+	workers := maxAccessReviewWorkers
+	if len(namespaces) < workers {
+		workers = len(namespaces)
+	}
+	jobs := make(chan string, workers)
+	results := make(chan namespaceAccessResult, workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for namespace := range jobs {
+				allowed, err := isAllowed(ctx, client, attributesForNamespace(namespace))
+				results <- namespaceAccessResult{namespace, allowed, err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	go func() {
+		for _, namespace := range namespaces {
+			jobs <- namespace
+		}
+		close(jobs)
+	}()
+
+	// All the results are received, even after an error, so that no worker
+	// is left blocked.
+	allowedNamespaces := []string{}
+	var accessErr error
+	for result := range results {
+		if result.err != nil {
+			if accessErr == nil {
+				accessErr = status.Errorf(codes.Internal, "Unable to check if the user has access to the namespace %q: %v", result.namespace, result.err)
+			}
+			continue
+		}
+		if result.allowed {
+			allowedNamespaces = append(allowedNamespaces, result.namespace)
+		}
+	}
+	if accessErr != nil {
+		return nil, accessErr
+	}
+	sort.Strings(allowedNamespaces)
+	return allowedNamespaces, nil
+}
+
+// isAllowed returns whether the user is allowed the resource attributes,
+// using a SelfSubjectAccessReview.
+func isAllowed(ctx context.Context, client kubernetes.Interface, attributes *authorizationv1.ResourceAttributes) (bool, error) {
+	res, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: attributes,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return res.Status.Allowed, nil
+}
+
 // isValidChart returns true if the chart model passed defines a value
 // for each required field described at the Helm website:
 // https://helm.sh/docs/topics/charts/#the-chartyaml-file
@@ -598,6 +833,11 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
+	if namespace != "" {
+		if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+			return nil, err
+		}
+	}
 	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
@@ -607,17 +847,34 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		cmd.AllNamespaces = true
 	}
 
-	cmd.Limit = int(request.GetPaginationOptions().GetPageSize())
-	cmd.Offset, err = pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	limit := int(request.GetPaginationOptions().GetPageSize())
+	offset, err := pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
 	}
 
-	// TODO(mnelson): Check whether we need to support ListAll (status == "all" in existing helm support)
+	cmd.StateMask = listStatesForStatusFilters(request.GetStatusFilters())
 
-	releases, err := cmd.Run()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to run Helm List action: %v", err)
+	var releases []*release.Release
+	if namespace == "" && s.storageDriver == "sql" {
+		// The releases of the namespaces which the user cannot read are
+		// filtered out before paginating them.
+		readableNamespaces, err := s.releasesReadableNamespaces(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		releases, err = cmd.Run()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to run Helm List action: %v", err)
+		}
+		releases = paginateReleases(filterReleasesByNamespace(releases, readableNamespaces), limit, offset)
+	} else {
+		cmd.Limit = limit
+		cmd.Offset = offset
+		releases, err = cmd.Run()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to run Helm List action: %v", err)
+		}
 	}
 
 	installedPkgSummaries := make([]*corev1.InstalledPackageSummary, len(releases))
@@ -626,38 +883,23 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 		installedPkgSummaries[i].InstalledPackageRef.Context.Cluster = cluster
 	}
 
-	// Fill in the latest package version for each.
-	// TODO(mnelson): Update to do this with a single query rather than iterating and
-	// querying per release.
+	// Fill in the latest package version for each with a single query.
+	latestVersionQueries := make([]utils.LatestChartVersionQuery, len(releases))
 	for i, rel := range releases {
-		// Helm does not store a back-reference to the chart used to create a
-		// release (https://github.com/helm/helm/issues/6464), so for each
-		// release, we look up a chart with than name and version available in
-		// the release namespace, and if one is found, pull out the latest chart
-		// version.
-		cq := utils.ChartQuery{
-			Namespace:  rel.Namespace,
-			ChartName:  rel.Chart.Metadata.Name,
-			Version:    rel.Chart.Metadata.Version,
-			AppVersion: rel.Chart.Metadata.AppVersion,
-		}
-		charts, _, err := s.manager.GetPaginatedChartListWithFilters(cq, 1, 0)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
-		}
-		// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
-		// Example: global + namespaced repo including an overlapping subset of packages.
-		if len(charts) > 0 && len(charts[0].ChartVersions) > 0 {
+		latestVersionQueries[i] = latestChartVersionQueryForRelease(rel)
+	}
+	latestVersions, err := s.manager.GetLatestChartVersions(latestVersionQueries)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error while fetching related charts: %v", err)
+	}
+	for i, rel := range releases {
+		if latestVersion, ok := latestVersions[latestVersionQueries[i]]; ok {
 			installedPkgSummaries[i].LatestVersion = &corev1.PackageAppVersion{
-				PkgVersion: charts[0].ChartVersions[0].Version,
-				AppVersion: charts[0].ChartVersions[0].AppVersion,
+				PkgVersion: latestVersion.Version,
+				AppVersion: latestVersion.AppVersion,
 			}
 		}
-		installedPkgSummaries[i].Status = &corev1.InstalledPackageStatus{
-			Ready:      rel.Info.Status == release.StatusDeployed,
-			Reason:     statusReasonForHelmStatus(rel.Info.Status),
-			UserReason: rel.Info.Status.String(),
-		}
+		installedPkgSummaries[i].Status = installedPackageStatusFromRelease(rel)
 		installedPkgSummaries[i].CurrentVersion = &corev1.PackageAppVersion{
 			PkgVersion: rel.Chart.Metadata.Version,
 			AppVersion: rel.Chart.Metadata.AppVersion,
@@ -667,12 +909,71 @@ func (s *Server) GetInstalledPackageSummaries(ctx context.Context, request *core
 	response := &corev1.GetInstalledPackageSummariesResponse{
 		InstalledPackageSummaries: installedPkgSummaries,
 	}
-	if len(releases) == cmd.Limit {
-		response.NextPageToken = fmt.Sprintf("%d", cmd.Limit+1)
+	if limit > 0 && len(releases) == limit {
+		response.NextPageToken = fmt.Sprintf("%d", offset+limit)
 	}
 	return response, nil
 }
 
+// filterReleasesByNamespace returns the releases of the given namespaces.
+func filterReleasesByNamespace(releases []*release.Release, namespaces sets.String) []*release.Release {
+	filtered := []*release.Release{}
+	for _, r := range releases {
+		if namespaces.Has(r.Namespace) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// paginateReleases returns the page of releases at the given offset, as the
+// Helm List action does.
+func paginateReleases(releases []*release.Release, limit, offset int) []*release.Release {
+	if offset >= len(releases) {
+		return []*release.Release{}
+	}
+	last := len(releases)
+	if limit > 0 && offset+limit < last {
+		last = offset + limit
+	}
+	return releases[offset:last]
+}
+
+// listStatesForStatusFilters returns the Helm list states matching the
+// requested status filters, defaulting to the deployed and failed releases
+// as 'helm list' does.
+func listStatesForStatusFilters(filters []corev1.GetInstalledPackageSummariesRequest_StatusFilter) action.ListStates {
+	if len(filters) == 0 {
+		return action.ListDeployed | action.ListFailed
+	}
+	var states action.ListStates
+	for _, filter := range filters {
+		switch filter {
+		case corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_DEPLOYED:
+			states |= action.ListDeployed
+		case corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_FAILED:
+			states |= action.ListFailed
+		case corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_PENDING:
+			states |= action.ListPendingInstall | action.ListPendingUpgrade | action.ListPendingRollback
+		case corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_UNINSTALLED:
+			states |= action.ListUninstalled
+		case corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_ALL:
+			states |= action.ListAll
+		default:
+			states |= action.ListDeployed | action.ListFailed
+		}
+	}
+	return states
+}
+
+func installedPackageStatusFromRelease(r *release.Release) *corev1.InstalledPackageStatus {
+	return &corev1.InstalledPackageStatus{
+		Ready:      r.Info.Status == release.StatusDeployed,
+		Reason:     statusReasonForHelmStatus(r.Info.Status),
+		UserReason: r.Info.Status.String(),
+	}
+}
+
 func statusReasonForHelmStatus(s release.Status) corev1.InstalledPackageStatus_StatusReason {
 	switch s {
 	case release.StatusDeployed:
@@ -694,10 +995,8 @@ func installedPkgSummaryFromRelease(r *release.Release) *corev1.InstalledPackage
 			},
 			Identifier: r.Name,
 		},
-		Name: r.Name,
-		PkgVersionReference: &corev1.VersionReference{
-			Version: r.Chart.Metadata.Version,
-		},
+		Name:                r.Name,
+		PkgVersionReference: pkgVersionReferenceForRelease(r),
 		CurrentVersion: &corev1.PackageAppVersion{
 			PkgVersion: r.Chart.Metadata.Version,
 			AppVersion: r.Chart.Metadata.AppVersion,
@@ -715,6 +1014,9 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	identifier := request.GetInstalledPackageRef().GetIdentifier()
 	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
 	log.Infof("+helm GetInstalledPackageDetail %s, id: %q", contextMsg, identifier)
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
 	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
@@ -747,36 +1049,29 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	installedPkgDetail.ValuesApplied = string(valuesMarshalled)
 
 	// Check for a chart matching the installed package.
-	cq := utils.ChartQuery{
-		Namespace:  release.Namespace,
-		ChartName:  release.Chart.Metadata.Name,
-		Version:    release.Chart.Metadata.Version,
-		AppVersion: release.Chart.Metadata.AppVersion,
-	}
-	charts, _, err := s.manager.GetPaginatedChartListWithFilters(cq, 1, 0)
+	chart, err := s.availableChartForRelease(release)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error while fetching related chart: %v", err)
 	}
-	// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
-	// Example: global + namespaced repo including an overlapping subset of packages.
-	if len(charts) > 0 {
+	if chart != nil {
 		installedPkgDetail.AvailablePackageRef = &corev1.AvailablePackageReference{
-			Identifier: charts[0].ID,
+			Identifier: chart.ID,
 			Plugin:     GetPluginDetail(),
 		}
-		if charts[0].Repo != nil {
+		if chart.Repo != nil {
 			installedPkgDetail.AvailablePackageRef.Context = &corev1.Context{
-				Namespace: charts[0].Repo.Namespace,
+				Namespace: chart.Repo.Namespace,
 				Cluster:   s.globalPackagingCluster,
 			}
 		}
-		if len(charts[0].ChartVersions) > 0 {
-			cv := charts[0].ChartVersions[0]
+		if len(chart.ChartVersions) > 0 {
+			cv := chart.ChartVersions[0]
 			installedPkgDetail.LatestVersion = &corev1.PackageAppVersion{
 				PkgVersion: cv.Version,
 				AppVersion: cv.AppVersion,
 			}
 		}
+		installedPkgDetail.LatestMatchingVersion = latestMatchingVersion(release, chart.ChartVersions)
 	}
 
 	return &corev1.GetInstalledPackageDetailResponse{
@@ -784,6 +1079,66 @@ func (s *Server) GetInstalledPackageDetail(ctx context.Context, request *corev1.
 	}, nil
 }
 
+// latestChartVersionQueryForRelease returns the query identifying the chart
+// from which the release was installed, using the chart source recorded on
+// the release when available or, as availableChartForRelease does, the name
+// and version of the chart of the release otherwise.
+func latestChartVersionQueryForRelease(rel *release.Release) utils.LatestChartVersionQuery {
+	annotations := rel.Chart.Metadata.Annotations
+	repoNamespace, chartID := annotations[AppRepositoryNamespaceAnnotation], annotations[ChartIDAnnotation]
+	if repoNamespace != "" && chartID != "" {
+		return utils.LatestChartVersionQuery{
+			Namespace: repoNamespace,
+			ChartID:   chartID,
+		}
+	}
+	return utils.LatestChartVersionQuery{
+		Namespace:  rel.Namespace,
+		ChartName:  rel.Chart.Metadata.Name,
+		Version:    rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+}
+
+// availableChartForRelease returns the chart from which a release was
+// installed, or nil if it is not available.
+//
+// Helm does not store a back-reference to the chart used to create a release
+// (https://github.com/helm/helm/issues/6464), so releases created or upgraded
+// by this plugin record it with annotations on the chart of the release. For
+// other releases, we look up a chart with that name and version available in
+// the release namespace.
+func (s *Server) availableChartForRelease(rel *release.Release) (*models.Chart, error) {
+	annotations := rel.Chart.Metadata.Annotations
+	repoNamespace, chartID := annotations[AppRepositoryNamespaceAnnotation], annotations[ChartIDAnnotation]
+	if repoNamespace != "" && chartID != "" {
+		chart, err := s.manager.GetChart(repoNamespace, chartID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// The chart, or its repository, was removed.
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &chart, nil
+	}
+
+	cq := utils.ChartQuery{
+		Namespace:  rel.Namespace,
+		ChartName:  rel.Chart.Metadata.Name,
+		Version:    rel.Chart.Metadata.Version,
+		AppVersion: rel.Chart.Metadata.AppVersion,
+	}
+	charts, _, err := s.manager.GetPaginatedChartListWithFilters(cq, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	// TODO(agamez): deal with multiple matches, perhaps returning []AvailablePackageRef ?
+	// Example: global + namespaced repo including an overlapping subset of packages.
+	// Until then, the same chart is chosen as for the installed package summaries.
+	return utils.PreferredChartForNamespace(charts, rel.Namespace, s.globalPackagingNamespace), nil
+}
+
 func installedPkgDetailFromRelease(r *release.Release, ref *corev1.InstalledPackageReference) (*corev1.InstalledPackageDetail, error) {
 	customDetailHelm, err := anypb.New(&helmv1.InstalledPackageDetailCustomDataHelm{
 		ReleaseRevision: int32(r.Version),
@@ -794,20 +1149,14 @@ func installedPkgDetailFromRelease(r *release.Release, ref *corev1.InstalledPack
 	return &corev1.InstalledPackageDetail{
 		InstalledPackageRef: ref,
 		Name:                r.Name,
-		PkgVersionReference: &corev1.VersionReference{
-			Version: r.Chart.Metadata.Version,
-		},
+		PkgVersionReference: pkgVersionReferenceForRelease(r),
 		CurrentVersion: &corev1.PackageAppVersion{
 			PkgVersion: r.Chart.Metadata.Version,
 			AppVersion: r.Chart.Metadata.AppVersion,
 		},
 		PostInstallationNotes: r.Info.Notes,
-		Status: &corev1.InstalledPackageStatus{
-			Ready:      r.Info.Status == release.StatusDeployed,
-			Reason:     statusReasonForHelmStatus(r.Info.Status),
-			UserReason: r.Info.Status.String(),
-		},
-		CustomDetail: customDetailHelm,
+		Status:                installedPackageStatusFromRelease(r),
+		CustomDetail:          customDetailHelm,
 	}, nil
 }
 
@@ -825,64 +1174,38 @@ func splitChartIdentifier(chartID string) (repoName, chartName string, err error
 func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.CreateInstalledPackageRequest) (*corev1.CreateInstalledPackageResponse, error) {
 	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
 	log.Infof("+helm CreateInstalledPackage %s", contextMsg)
-	// Get the AppRepository for the available package.
-	// TODO: currently app repositories are only supported on the cluster on
-	// which Kubeapps is installed. #1982
-	chartID := request.GetAvailablePackageRef().GetIdentifier()
-	repoNamespace := request.GetAvailablePackageRef().GetContext().GetNamespace()
-	repoName, chartName, err := splitChartIdentifier(chartID)
-	chartVersion := request.GetPkgVersionReference().GetVersion()
-	if err != nil {
-		return nil, err
-	}
 
-	// Most of the existing code that we want to reuse is based on having a typed AppRepository.
-	appRepo, caCertSecret, authSecret, err := s.getAppRepoAndRelatedSecrets(ctx, repoName, repoNamespace)
+	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, request.GetAvailablePackageRef(), request.GetPkgVersionReference().GetVersion())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to fetch app repo %q from namespace %q: %v", repoName, repoNamespace, err)
+		return nil, err
 	}
 
-	userAgentString := fmt.Sprintf("%s/%s/%s/%s", UserAgentPrefix, pluginDetail.Name, pluginDetail.Version, version)
-
-	log.Infof("+helm CreateInstalledPackage fetching chart %q with user-agent %q", chartID, userAgentString)
-
-	// Grab the chart itself
-	ch, err := handlerutil.GetChart(
-		&chart.Details{
-			AppRepositoryResourceName:      appRepo.Name,
-			AppRepositoryResourceNamespace: appRepo.Namespace,
-			ChartName:                      chartName,
-			Version:                        chartVersion,
-		},
-		appRepo,
-		caCertSecret, authSecret,
-		s.chartClientFactory.New(appRepo.Spec.Type, userAgentString),
-	)
-
-	// Create an action config for the target namespace.
 	namespace := request.GetTargetContext().GetNamespace()
 	cluster := request.GetTargetContext().GetCluster()
 	if cluster == "" {
 		cluster = s.globalPackagingCluster
 	}
-	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	values, err := s.valuesFromSources(ctx, cluster, namespace, ch, request.GetValueSources(), request.GetValues())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+		return nil, err
+	}
+	if err = validateValuesAgainstSchema(ch, values); err != nil {
+		return nil, err
 	}
 
-	// We currently get app repositories on the kubeapps cluster only.
-	typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
 	}
-	registrySecrets, err := chart.RegistrySecretsPerDomain(ctx, appRepo.Spec.DockerRegistrySecrets, appRepo.Namespace, typedClient)
+
+	// Create an action config for the target namespace.
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to fetch registry secrets from the namespace %q: %v", appRepo.Namespace, err)
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
 	}
 
-	release, err := agent.CreateRelease(actionConfig, request.GetName(), namespace, request.GetValues(), ch, registrySecrets)
+	release, err := agent.CreateRelease(actionConfig, request.GetName(), namespace, values, ch, registrySecrets)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Unable to create helm release %q in the namespace %q: %v", request.GetName(), appRepo.Namespace, err)
+		return nil, status.Errorf(codes.Internal, "Unable to create helm release %q in the namespace %q: %v", request.GetName(), namespace, err)
 	}
 
 	return &corev1.CreateInstalledPackageResponse{
@@ -897,53 +1220,1111 @@ func (s *Server) CreateInstalledPackage(ctx context.Context, request *corev1.Cre
 	}, nil
 }
 
-// GetAppRepoAndRelatedSecrets retrieves the given repo from its namespace
-// Depending on the repo namespace and the
-func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, error) {
-
-	// We currently get app repositories on the kubeapps cluster only.
-	typedClient, dynClient, err := s.GetClients(ctx, s.globalPackagingCluster)
+// validateValuesAgainstSchema checks the values, coalesced with the defaults
+// of the chart as Helm does, against the values.schema.json of the chart and
+// its enabled dependencies. Violations are returned as an InvalidArgument
+// error with a BadRequest detail listing the path and message of each field.
+func validateValuesAgainstSchema(ch *helmchart.Chart, valuesYaml string) error {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(valuesYaml), &values); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Unable to parse the values: %v", err)
+	}
+	// Helm only validates the dependencies enabled by the values, which it
+	// determines when processing them. Processing the dependencies modifies
+	// the chart, so a copy is processed to install the chart untouched.
+	ch = copyChartForProcessing(ch)
+	if err := chartutil.ProcessDependencies(ch, values); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Unable to process the dependencies of the chart %q: %v", ch.Name(), err)
+	}
+	coalescedValues, err := chartutil.CoalesceValues(ch, values)
 	if err != nil {
-		return nil, nil, nil, err
+		return status.Errorf(codes.InvalidArgument, "Unable to coalesce the values of the chart %q: %v", ch.Name(), err)
 	}
 
-	// Using the dynamic client to get the AppRepository rather than updating the interface
-	// returned by the client getter, then converting the result back to a typed AppRepository
-	// since our existing code that we're re-using expects one.
-	gvr := schema.GroupVersionResource{
-		Group:    "kubeapps.com",
-		Version:  "v1alpha1",
-		Resource: "apprepositories",
-	}
-	appRepoUnstructured, err := dynClient.Resource(gvr).Namespace(appRepoNamespace).Get(ctx, appRepoName, metav1.GetOptions{})
+	violations, err := schemaViolations(ch, coalescedValues, "")
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("unable to get app repository %s/%s: %v", appRepoNamespace, appRepoName, err)
+		return status.Errorf(codes.Internal, "Unable to validate the values of the chart %q: %v", ch.Name(), err)
+	}
+	if len(violations) == 0 {
+		return nil
 	}
 
-	var appRepo appRepov1.AppRepository
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(appRepoUnstructured.UnstructuredContent(), &appRepo)
+	st, err := status.New(codes.InvalidArgument, fmt.Sprintf("The values do not match the schema of the chart %q", ch.Name())).
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to convert unstructured AppRepository for %s/%s to a structured AppRepository: %v", appRepoNamespace, appRepoName, err)
+		return status.Errorf(codes.Internal, "Unable to add the schema violations to the error: %v", err)
 	}
+	return st.Err()
+}
 
-	auth := appRepo.Spec.Auth
-	var caCertSecret *corek8sv1.Secret
-	if auth.CustomCA != nil {
-		secretName := auth.CustomCA.SecretKeyRef.Name
-		caCertSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
+// copyChartForProcessing returns a copy of the chart and its dependencies
+// which can be modified by chartutil.ProcessDependencies: the list of
+// dependencies and their metadata are copied, while the templates, files and
+// values, which are only replaced, are shared with the original chart.
+func copyChartForProcessing(ch *helmchart.Chart) *helmchart.Chart {
+	c := *ch
+	if ch.Metadata != nil {
+		metadata := *ch.Metadata
+		metadata.Dependencies = nil
+		for _, dep := range ch.Metadata.Dependencies {
+			d := *dep
+			metadata.Dependencies = append(metadata.Dependencies, &d)
+		}
+		c.Metadata = &metadata
+	}
+	dependencies := make([]*helmchart.Chart, len(ch.Dependencies()))
+	for i, dep := range ch.Dependencies() {
+		dependencies[i] = copyChartForProcessing(dep)
+	}
+	c.SetDependencies(dependencies...)
+	return &c
+}
+
+// schemaViolations returns the violations of the schemas of a chart and its
+// dependencies by the values, with the field paths prefixed by the path of
+// the chart values within those of the parent chart.
+func schemaViolations(ch *helmchart.Chart, values map[string]interface{}, prefix string) ([]*errdetails.BadRequest_FieldViolation, error) {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	if ch.Schema != nil {
+		valuesJSON, err := json.Marshal(values)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", auth.CustomCA.SecretKeyRef.Name, err)
+			return nil, err
+		}
+		if string(valuesJSON) == "null" {
+			valuesJSON = []byte("{}")
+		}
+		result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(ch.Schema), gojsonschema.NewBytesLoader(valuesJSON))
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range result.Errors() {
+			field := schemaFieldPath(prefix, desc.Field())
+			// A missing required field is reported for the object containing it.
+			if property, ok := desc.Details()["property"].(string); ok && desc.Type() == "required" {
+				field = schemaFieldPath(field, property)
+			}
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: desc.Description(),
+			})
 		}
 	}
 
-	var authSecret *corek8sv1.Secret
-	if auth.Header != nil {
-		secretName := auth.Header.SecretKeyRef.Name
-		authSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
+	for _, dependency := range ch.Dependencies() {
+		dependencyValues, ok := values[dependency.Name()].(map[string]interface{})
+		if !ok {
+			dependencyValues = map[string]interface{}{}
+		}
+		dependencyViolations, err := schemaViolations(dependency, dependencyValues, schemaFieldPath(prefix, dependency.Name()))
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", secretName, err)
+			return nil, err
 		}
+		violations = append(violations, dependencyViolations...)
 	}
+	return violations, nil
+}
 
-	return &appRepo, caCertSecret, authSecret, nil
+// schemaFieldPath joins a prefix with the path of a field as reported by
+// gojsonschema, which uses "(root)" for the values themselves.
+func schemaFieldPath(prefix, field string) string {
+	if field == gojsonschema.STRING_CONTEXT_ROOT {
+		field = ""
+	}
+	switch {
+	case prefix == "":
+		return field
+	case field == "":
+		return prefix
+	}
+	return prefix + "." + field
+}
+
+// presetsConfigMapNameForRepo returns the name of the ConfigMap which stores
+// the presets of values for the charts of an AppRepository, in the namespace
+// of the AppRepository, with one key per preset. This convention is documented
+// for the maintainers of the repositories in
+// docs/user/private-app-repository.md and must be kept in sync.
+func presetsConfigMapNameForRepo(repoName string) string {
+	return fmt.Sprintf("apprepo-%s-presets", repoName)
+}
+
+// valuesFromSources returns the values for a release, deep-merging the values
+// of each source in order before layering the request values on top of them.
+// The request values are returned unchanged when there are no sources.
+func (s *Server) valuesFromSources(ctx context.Context, cluster, namespace string, ch *helmchart.Chart, sources []*corev1.ValueSource, values string) (string, error) {
+	if len(sources) == 0 {
+		return values, nil
+	}
+
+	merged := map[string]interface{}{}
+	for i, source := range sources {
+		sourceValues, err := s.valuesForSource(ctx, cluster, namespace, ch, source)
+		if err != nil {
+			return "", err
+		}
+		parsedValues := map[string]interface{}{}
+		if err = yaml.Unmarshal([]byte(sourceValues), &parsedValues); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "Unable to parse the values of the value source %d: %v", i, err)
+		}
+		merged = helm.MergeValues(merged, parsedValues)
+	}
+	parsedValues := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(values), &parsedValues); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "Unable to parse the values: %v", err)
+	}
+	merged = helm.MergeValues(merged, parsedValues)
+
+	mergedValues, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to serialize the merged values: %v", err)
+	}
+	return string(mergedValues), nil
+}
+
+// valuesForSource returns the serialized values of a single value source. The
+// ConfigMaps and Secrets are read from the target namespace while the presets
+// are read from the AppRepository of the chart, as recorded in its annotations.
+func (s *Server) valuesForSource(ctx context.Context, cluster, namespace string, ch *helmchart.Chart, source *corev1.ValueSource) (string, error) {
+	switch src := source.GetSource().(type) {
+	case *corev1.ValueSource_Inline:
+		return src.Inline, nil
+
+	case *corev1.ValueSource_ConfigMapKeyRef:
+		typedClient, _, err := s.GetClients(ctx, cluster)
+		if err != nil {
+			return "", err
+		}
+		name, key := src.ConfigMapKeyRef.GetName(), src.ConfigMapKeyRef.GetKey()
+		configMap, err := typedClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", statusForValueSourceError(err, "ConfigMap", namespace, name)
+		}
+		data, ok := configMap.Data[key]
+		if !ok {
+			return "", status.Errorf(codes.NotFound, "Unable to find the key %q in the ConfigMap %q in the namespace %q", key, name, namespace)
+		}
+		return data, nil
+
+	case *corev1.ValueSource_SecretKeyRef:
+		typedClient, _, err := s.GetClients(ctx, cluster)
+		if err != nil {
+			return "", err
+		}
+		name, key := src.SecretKeyRef.GetName(), src.SecretKeyRef.GetKey()
+		secret, err := typedClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", statusForValueSourceError(err, "Secret", namespace, name)
+		}
+		data, ok := secret.Data[key]
+		if !ok {
+			return "", status.Errorf(codes.NotFound, "Unable to find the key %q in the Secret %q in the namespace %q", key, name, namespace)
+		}
+		return string(data), nil
+
+	case *corev1.ValueSource_Preset:
+		repoNamespace := ch.Metadata.Annotations[AppRepositoryNamespaceAnnotation]
+		repoName := ch.Metadata.Annotations[AppRepositoryNameAnnotation]
+		if repoNamespace == "" || repoName == "" {
+			return "", status.Errorf(codes.FailedPrecondition, "Unable to find the AppRepository of the chart %q for the preset %q", ch.Name(), src.Preset)
+		}
+		// We currently get app repositories on the kubeapps cluster only.
+		typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
+		if err != nil {
+			return "", err
+		}
+		name := presetsConfigMapNameForRepo(repoName)
+		configMap, err := typedClient.CoreV1().ConfigMaps(repoNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", statusForValueSourceError(err, "ConfigMap", repoNamespace, name)
+		}
+		data, ok := configMap.Data[src.Preset]
+		if !ok {
+			return "", status.Errorf(codes.NotFound, "Unable to find the preset %q of the AppRepository %q in the namespace %q", src.Preset, repoName, repoNamespace)
+		}
+		return data, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "Unsupported value source: %v", source)
+}
+
+func statusForValueSourceError(err error, kind, namespace, name string) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return status.Errorf(codes.NotFound, "Unable to find the %s %q in the namespace %q: %v", kind, name, namespace, err)
+	case apierrors.IsForbidden(err):
+		return status.Errorf(codes.PermissionDenied, "Unable to read the %s %q in the namespace %q: %v", kind, name, namespace, err)
+	}
+	return status.Errorf(codes.Internal, "Unable to read the %s %q in the namespace %q: %v", kind, name, namespace, err)
+}
+
+// RenderInstalledPackage renders the manifest of a chart as it would be
+// installed, using a client-only helm install which neither accesses the
+// cluster nor stores a release.
+func (s *Server) RenderInstalledPackage(ctx context.Context, request *corev1.RenderInstalledPackageRequest) (*corev1.RenderInstalledPackageResponse, error) {
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.GetTargetContext().GetCluster(), request.GetTargetContext().GetNamespace())
+	log.Infof("+helm RenderInstalledPackage %s", contextMsg)
+
+	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, request.GetAvailablePackageRef(), request.GetPkgVersionReference().GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := request.GetTargetContext().GetNamespace()
+	cluster := request.GetTargetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	values, err := s.valuesFromSources(ctx, cluster, namespace, ch, request.GetValueSources(), request.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := agent.RenderRelease(request.GetName(), namespace, values, ch, registrySecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to render helm release %q in the namespace %q: %v", request.GetName(), namespace, err)
+	}
+
+	refs, err := server.ResourceRefsFromManifest(manifest, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to parse the rendered manifest: %v", err)
+	}
+
+	return &corev1.RenderInstalledPackageResponse{
+		Manifest:     manifest,
+		ResourceRefs: refs,
+	}, nil
+}
+
+// UpdateInstalledPackage updates an installed package.
+func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.UpdateInstalledPackageRequest) (*corev1.UpdateInstalledPackageResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm UpdateInstalledPackage %s, id: %q", contextMsg, releaseName)
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	currentRelease, err := getReleaseForUpdate(actionConfig, releaseName, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, registrySecrets, err := s.fetchChartForUpdate(ctx, installedRef, currentRelease, request.GetPkgVersionReference().GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.valuesForUpdate(ctx, cluster, namespace, ch, currentRelease, request.GetValueSources(), request.GetValues())
+	if err != nil {
+		return nil, err
+	}
+	if err = validateValuesAgainstSchema(ch, values); err != nil {
+		return nil, err
+	}
+
+	release, err := agent.UpgradeRelease(actionConfig, releaseName, values, ch, registrySecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to upgrade helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+
+	return &corev1.UpdateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: release.Namespace,
+			},
+			Identifier: release.Name,
+			Plugin:     GetPluginDetail(),
+		},
+	}, nil
+}
+
+// DiffInstalledPackage returns the differences between the resources of a
+// release and those of its upgrade, rendered with a dry-run upgrade.
+func (s *Server) DiffInstalledPackage(ctx context.Context, request *corev1.DiffInstalledPackageRequest) (*corev1.DiffInstalledPackageResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm DiffInstalledPackage %s, id: %q", contextMsg, releaseName)
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	release, err := getReleaseForUpdate(actionConfig, releaseName, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, registrySecrets, err := s.fetchChartForUpdate(ctx, installedRef, release, request.GetPkgVersionReference().GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.valuesForUpdate(ctx, cluster, namespace, ch, release, request.GetValueSources(), request.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	targetManifest, err := agent.RenderUpgradeRelease(actionConfig, releaseName, values, ch, registrySecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to render the upgrade of helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+
+	diffs, err := server.DiffManifests(agent.ReleaseManifest(release), targetManifest, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to diff the manifests: %v", err)
+	}
+
+	return &corev1.DiffInstalledPackageResponse{
+		ResourceDiffs: diffs,
+	}, nil
+}
+
+// getReleaseForUpdate returns the current release with the given name.
+func getReleaseForUpdate(actionConfig *action.Configuration, releaseName, namespace string) (*release.Release, error) {
+	rel, err := agent.GetRelease(actionConfig, releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", releaseName, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get Helm release %q in namespace %q: %+v", releaseName, namespace, err)
+	}
+	return rel, nil
+}
+
+// fetchChartForUpdate fetches the chart, at the given version, of the
+// available package used to deploy an installed package. Without a version,
+// the chart is fetched at the current version of the release rather than
+// upgrading it to the latest one, and keeps the version constraint recorded
+// with the release, if any.
+func (s *Server) fetchChartForUpdate(ctx context.Context, installedRef *corev1.InstalledPackageReference, rel *release.Release, version string) (*helmchart.Chart, map[string]string, error) {
+	versionConstraint := ""
+	if version == "" {
+		version = rel.Chart.Metadata.Version
+		versionConstraint = rel.Chart.Metadata.Annotations[VersionConstraintAnnotation]
+	}
+
+	// Helm does not store a reference to the chart used for a release, so
+	// we rely on the available package reference determined for the
+	// installed package detail.
+	detailResponse, err := s.GetInstalledPackageDetail(ctx, &corev1.GetInstalledPackageDetailRequest{
+		InstalledPackageRef: installedRef,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	availablePkgRef := detailResponse.GetInstalledPackageDetail().GetAvailablePackageRef()
+	if availablePkgRef == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "Unable to find the available package used to deploy %q in the namespace %q", installedRef.GetIdentifier(), installedRef.GetContext().GetNamespace())
+	}
+
+	ch, registrySecrets, err := s.fetchChartWithRegistrySecrets(ctx, availablePkgRef, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if versionConstraint != "" {
+		ch.Metadata.Annotations[VersionConstraintAnnotation] = versionConstraint
+	}
+	return ch, registrySecrets, nil
+}
+
+// valuesForUpdate returns the values for the upgrade of a release. Without
+// values nor value sources in the request, the release keeps the values it
+// was configured with, since helm would otherwise reset them to the defaults
+// of the chart.
+func (s *Server) valuesForUpdate(ctx context.Context, cluster, namespace string, ch *helmchart.Chart, rel *release.Release, sources []*corev1.ValueSource, values string) (string, error) {
+	if values != "" || len(sources) > 0 {
+		return s.valuesFromSources(ctx, cluster, namespace, ch, sources, values)
+	}
+	if len(rel.Config) == 0 {
+		return "", nil
+	}
+	currentValues, err := yaml.Marshal(rel.Config)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Unable to serialize the values of the helm release %q: %v", rel.Name, err)
+	}
+	return string(currentValues), nil
+}
+
+// DeleteInstalledPackage deletes an installed package based on the request.
+func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm DeleteInstalledPackage %s, id: %q", contextMsg, releaseName)
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	err = agent.DeleteRelease(actionConfig, releaseName, request.GetKeepHistory())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to delete helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+
+	return &corev1.DeleteInstalledPackageResponse{}, nil
+}
+
+// GetInstalledPackageResourceRefs returns the references for the Kubernetes
+// resources in the manifest of the helm release.
+func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageResourceRefs %s, id: %q", contextMsg, installedRef.GetIdentifier())
+
+	refs, err := s.resourceRefsForRelease(ctx, installedRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageResourceRefsResponse{
+		ResourceRefs: refs,
+	}, nil
+}
+
+// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes
+// resources in the manifest of the helm release.
+func (s *Server) GetInstalledPackageResourceStatuses(ctx context.Context, request *corev1.GetInstalledPackageResourceStatusesRequest) (*corev1.GetInstalledPackageResourceStatusesResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", installedRef.GetContext().GetCluster(), installedRef.GetContext().GetNamespace())
+	log.Infof("+helm GetInstalledPackageResourceStatuses %s, id: %q", contextMsg, installedRef.GetIdentifier())
+
+	refs, err := s.resourceRefsForRelease(ctx, installedRef)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := installedRef.GetContext().GetCluster()
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+	typedClient, dynamicClient, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageResourceStatusesResponse{
+		ResourceStatuses: server.GetResourceStatuses(ctx, dynamicClient, server.NewResourceMapper(typedClient.Discovery()), refs),
+	}, nil
+}
+
+// resourceRefsForRelease returns the references for the resources in the
+// manifest of the latest revision of the referenced helm release.
+func (s *Server) resourceRefsForRelease(ctx context.Context, installedRef *corev1.InstalledPackageReference) ([]*corev1.ResourceRef, error) {
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+	rel, err := action.NewGet(actionConfig).Run(releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find Helm release %q in namespace %q: %+v", releaseName, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to run Helm get action: %v", err)
+	}
+
+	refs, err := server.ResourceRefsFromManifest(rel.Manifest, rel.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to parse the manifest of the release %q: %v", releaseName, err)
+	}
+	return refs, nil
+}
+
+// GetInstalledPackageRevisions returns the helm history of a release, from the
+// latest to the oldest revision.
+func (s *Server) GetInstalledPackageRevisions(ctx context.Context, request *corev1.GetInstalledPackageRevisionsRequest) (*corev1.GetInstalledPackageRevisionsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm GetInstalledPackageRevisions %s, id: %q", contextMsg, releaseName)
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	history, err := action.NewHistory(actionConfig).Run(releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get the history of the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Version > history[j].Version
+	})
+
+	revisions := make([]*corev1.InstalledPackageRevision, len(history))
+	for i, r := range history {
+		revisions[i] = installedPackageRevisionFromRelease(r)
+	}
+
+	return &corev1.GetInstalledPackageRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// installedPackageRevisionFromRelease returns the revision for a helm release
+// as stored for each of its versions.
+func installedPackageRevisionFromRelease(r *release.Release) *corev1.InstalledPackageRevision {
+	revision := &corev1.InstalledPackageRevision{
+		Revision: int32(r.Version),
+		Status:   installedPackageStatusFromRelease(r),
+	}
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		revision.Version = &corev1.PackageAppVersion{
+			PkgVersion: r.Chart.Metadata.Version,
+			AppVersion: r.Chart.Metadata.AppVersion,
+		}
+	}
+	if !r.Info.LastDeployed.IsZero() {
+		revision.Updated = timestamppb.New(r.Info.LastDeployed.Time)
+	}
+	return revision
+}
+
+// RollbackInstalledPackage rolls back a helm release to a previous revision,
+// which creates a new revision with the chart and values of the previous one.
+func (s *Server) RollbackInstalledPackage(ctx context.Context, request *corev1.RollbackInstalledPackageRequest) (*corev1.RollbackInstalledPackageResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm RollbackInstalledPackage %s, id: %q, revision: %d", contextMsg, releaseName, request.GetRevision())
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if request.GetRevision() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid revision %d", request.GetRevision())
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	rel, err := agent.RollbackRelease(actionConfig, releaseName, int(request.GetRevision()))
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find the revision %d of the helm release %q in the namespace %q: %v", request.GetRevision(), releaseName, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to roll back the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+	}
+
+	return &corev1.RollbackInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: rel.Namespace,
+			},
+			Identifier: rel.Name,
+			Plugin:     GetPluginDetail(),
+		},
+	}, nil
+}
+
+// defaultTestTimeout is the time to wait for each test of a release when the
+// request does not specify one, as for 'helm test'.
+const defaultTestTimeout = 300 * time.Second
+
+// RunInstalledPackageTests runs the test hooks of a helm release, as
+// 'helm test' does, and returns the result and pod logs of each of them.
+// Failing tests are reported in the results rather than as an error.
+func (s *Server) RunInstalledPackageTests(ctx context.Context, request *helmv1.RunInstalledPackageTestsRequest) (*helmv1.RunInstalledPackageTestsResponse, error) {
+	installedRef := request.GetInstalledPackageRef()
+	releaseName := installedRef.GetIdentifier()
+	namespace := installedRef.GetContext().GetNamespace()
+	cluster := installedRef.GetContext().GetCluster()
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm RunInstalledPackageTests %s, id: %q", contextMsg, releaseName)
+
+	if releaseName == "" || namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	if request.GetTimeoutSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid timeout %d", request.GetTimeoutSeconds())
+	}
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	if err := s.checkReleasesAccess(ctx, cluster, namespace); err != nil {
+		return nil, err
+	}
+	actionConfig, err := s.actionConfigGetter(ctx, cluster, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+	}
+
+	testAction := action.NewReleaseTesting(actionConfig)
+	testAction.Namespace = namespace
+	testAction.Timeout = defaultTestTimeout
+	if request.GetTimeoutSeconds() > 0 {
+		testAction.Timeout = time.Duration(request.GetTimeoutSeconds()) * time.Second
+	}
+	rel, err := testAction.Run(releaseName)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Unable to find the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+		}
+		if rel == nil {
+			return nil, status.Errorf(codes.Internal, "Unable to test the helm release %q in the namespace %q: %v", releaseName, namespace, err)
+		}
+		log.Infof("+helm RunInstalledPackageTests %s, id: %q, tests failed: %v", contextMsg, releaseName, err)
+	}
+
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*helmv1.InstalledPackageTestResult{}
+	for _, h := range testHooksForRelease(rel) {
+		result := installedPackageTestResultFromHook(h)
+		if h.Kind == "Pod" {
+			result.Logs = testPodLogs(ctx, typedClient, namespace, h.Name)
+		}
+		results = append(results, result)
+	}
+
+	return &helmv1.RunInstalledPackageTestsResponse{
+		TestResults: results,
+	}, nil
+}
+
+// testHooksForRelease returns the test hooks of a release which have been run,
+// ordered by the time at which they started.
+func testHooksForRelease(rel *release.Release) []*release.Hook {
+	hooks := []*release.Hook{}
+	for _, h := range rel.Hooks {
+		if h.LastRun.StartedAt.IsZero() {
+			continue
+		}
+		for _, e := range h.Events {
+			if e == release.HookTest {
+				hooks = append(hooks, h)
+				break
+			}
+		}
+	}
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].LastRun.StartedAt.Before(hooks[j].LastRun.StartedAt)
+	})
+	return hooks
+}
+
+// installedPackageTestResultFromHook returns the result of the last run of a
+// test hook.
+func installedPackageTestResultFromHook(h *release.Hook) *helmv1.InstalledPackageTestResult {
+	result := &helmv1.InstalledPackageTestResult{
+		Name:  h.Name,
+		Phase: testPhaseForHookPhase(h.LastRun.Phase),
+	}
+	if !h.LastRun.StartedAt.IsZero() {
+		result.StartedAt = timestamppb.New(h.LastRun.StartedAt.Time)
+	}
+	if !h.LastRun.CompletedAt.IsZero() {
+		result.CompletedAt = timestamppb.New(h.LastRun.CompletedAt.Time)
+	}
+	return result
+}
+
+func testPhaseForHookPhase(phase release.HookPhase) helmv1.InstalledPackageTestResult_TestPhase {
+	switch phase {
+	case release.HookPhaseRunning:
+		return helmv1.InstalledPackageTestResult_TEST_PHASE_RUNNING
+	case release.HookPhaseSucceeded:
+		return helmv1.InstalledPackageTestResult_TEST_PHASE_SUCCEEDED
+	case release.HookPhaseFailed:
+		return helmv1.InstalledPackageTestResult_TEST_PHASE_FAILED
+	}
+	return helmv1.InstalledPackageTestResult_TEST_PHASE_UNSPECIFIED
+}
+
+// The logs returned for each test are limited to the last lines of its pod,
+// up to a maximum size, as a test could log without bounds.
+const (
+	maxTestPodLogLines int64 = 500
+	maxTestPodLogBytes int64 = 64 * 1024
+)
+
+// testPodLogs returns the logs of the pod of a test hook. The pod may have
+// been removed by the delete policy of the hook, in which case no logs are
+// returned rather than failing the request.
+func testPodLogs(ctx context.Context, client kubernetes.Interface, namespace, podName string) string {
+	tailLines, limitBytes := maxTestPodLogLines, maxTestPodLogBytes
+	logOptions := &corek8sv1.PodLogOptions{
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	}
+	logReader, err := client.CoreV1().Pods(namespace).GetLogs(podName, logOptions).Stream(ctx)
+	if err != nil {
+		log.Infof("+helm RunInstalledPackageTests unable to get the logs of the pod %q in the namespace %q: %v", podName, namespace, err)
+		return ""
+	}
+	defer logReader.Close()
+	logs, err := ioutil.ReadAll(io.LimitReader(logReader, maxTestPodLogBytes))
+	if err != nil {
+		log.Infof("+helm RunInstalledPackageTests unable to read the logs of the pod %q in the namespace %q: %v", podName, namespace, err)
+		return ""
+	}
+	return string(logs)
+}
+
+// fetchChartWithRegistrySecrets returns the chart for the requested version of
+// an available package, together with the registry secrets configured for its
+// AppRepository.
+func (s *Server) fetchChartWithRegistrySecrets(ctx context.Context, availablePkgRef *corev1.AvailablePackageReference, chartVersion string) (*helmchart.Chart, map[string]string, error) {
+	// Get the AppRepository for the available package.
+	// TODO: currently app repositories are only supported on the cluster on
+	// which Kubeapps is installed. #1982
+	chartID := availablePkgRef.GetIdentifier()
+	repoNamespace := availablePkgRef.GetContext().GetNamespace()
+	repoName, chartName, err := splitChartIdentifier(chartID)
+	if err != nil {
+		return nil, nil, err
+	}
+	chartVersion, versionConstraint, err := s.resolveChartVersion(repoNamespace, chartID, chartVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Most of the existing code that we want to reuse is based on having a typed AppRepository.
+	appRepo, caCertSecret, authSecret, err := s.getAppRepoAndRelatedSecrets(ctx, repoName, repoNamespace)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch app repo %q from namespace %q: %v", repoName, repoNamespace, err)
+	}
+
+	userAgentString := fmt.Sprintf("%s/%s/%s/%s", UserAgentPrefix, pluginDetail.Name, pluginDetail.Version, version)
+
+	log.Infof("+helm fetching chart %q with user-agent %q", chartID, userAgentString)
+
+	// Grab the chart itself
+	ch, err := handlerutil.GetChart(
+		&chart.Details{
+			AppRepositoryResourceName:      appRepo.Name,
+			AppRepositoryResourceNamespace: appRepo.Namespace,
+			ChartName:                      chartName,
+			Version:                        chartVersion,
+		},
+		appRepo,
+		caCertSecret, authSecret,
+		s.chartClientFactory.New(appRepo.Spec.Type, userAgentString),
+	)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch the chart %q from the namespace %q: %v", chartID, repoNamespace, err)
+	}
+	annotateChartSource(ch, appRepo, chartID)
+	if versionConstraint != "" {
+		ch.Metadata.Annotations[VersionConstraintAnnotation] = versionConstraint
+	}
+
+	// We currently get app repositories on the kubeapps cluster only.
+	typedClient, _, err := s.GetClients(ctx, s.globalPackagingCluster)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Unable to create kubernetes clientset: %v", err)
+	}
+	registrySecrets, err := chart.RegistrySecretsPerDomain(ctx, appRepo.Spec.DockerRegistrySecrets, appRepo.Namespace, typedClient)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Unable to fetch registry secrets from the namespace %q: %v", appRepo.Namespace, err)
+	}
+
+	return ch, registrySecrets, nil
+}
+
+// annotateChartSource records the AppRepository and the ID of a chart on its
+// metadata, which helm stores with each release of the chart.
+func annotateChartSource(ch *helmchart.Chart, appRepo *appRepov1.AppRepository, chartID string) {
+	if ch.Metadata.Annotations == nil {
+		ch.Metadata.Annotations = map[string]string{}
+	}
+	ch.Metadata.Annotations[AppRepositoryNamespaceAnnotation] = appRepo.Namespace
+	ch.Metadata.Annotations[AppRepositoryNameAnnotation] = appRepo.Name
+	ch.Metadata.Annotations[ChartIDAnnotation] = chartID
+}
+
+// resolveChartVersion returns the chart version to fetch for the requested
+// version of an available package. An exact version is returned unchanged
+// while a semver constraint, such as "~1.4", resolves to the newest synced
+// version of the chart satisfying it and is returned as the constraint to
+// record with the release.
+func (s *Server) resolveChartVersion(repoNamespace, chartID, version string) (string, string, error) {
+	if version == "" {
+		return "", "", nil
+	}
+	if _, err := semver.NewVersion(version); err == nil {
+		return version, "", nil
+	}
+	constraint, err := semver.NewConstraint(version)
+	if err != nil {
+		return "", "", status.Errorf(codes.InvalidArgument, "Unable to parse the version %q as a version or a semver constraint: %v", version, err)
+	}
+
+	chart, err := s.manager.GetChart(repoNamespace, chartID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", status.Errorf(codes.NotFound, "Unable to find the chart %q in the namespace %q", chartID, repoNamespace)
+		}
+		return "", "", status.Errorf(codes.Internal, "Unable to retrieve the chart %q: %v", chartID, err)
+	}
+	// The chart versions are stored with the latest version first.
+	for _, cv := range chart.ChartVersions {
+		v, err := semver.NewVersion(cv.Version)
+		if err == nil && constraint.Check(v) {
+			return cv.Version, version, nil
+		}
+	}
+	return "", "", status.Errorf(codes.NotFound, "Unable to find a version of the chart %q satisfying %q", chartID, version)
+}
+
+// latestMatchingVersion returns the newest of the chart versions which
+// satisfies the version constraint of a release and is newer than its
+// current version, or nil if there is none.
+func latestMatchingVersion(rel *release.Release, chartVersions []models.ChartVersion) *corev1.PackageAppVersion {
+	constraint, err := semver.NewConstraint(rel.Chart.Metadata.Annotations[VersionConstraintAnnotation])
+	if err != nil {
+		return nil
+	}
+	currentVersion, err := semver.NewVersion(rel.Chart.Metadata.Version)
+	if err != nil {
+		return nil
+	}
+	for _, cv := range chartVersions {
+		v, err := semver.NewVersion(cv.Version)
+		if err != nil || !constraint.Check(v) {
+			continue
+		}
+		if v.GreaterThan(currentVersion) {
+			return &corev1.PackageAppVersion{
+				PkgVersion: cv.Version,
+				AppVersion: cv.AppVersion,
+			}
+		}
+		// The remaining versions are older.
+		return nil
+	}
+	return nil
+}
+
+// pkgVersionReferenceForRelease returns the version constraint recorded
+// with a release or, without one, its exact chart version.
+func pkgVersionReferenceForRelease(rel *release.Release) *corev1.VersionReference {
+	if constraint := rel.Chart.Metadata.Annotations[VersionConstraintAnnotation]; constraint != "" {
+		return &corev1.VersionReference{
+			Version: constraint,
+		}
+	}
+	return &corev1.VersionReference{
+		Version: rel.Chart.Metadata.Version,
+	}
+}
+
+// GetAppRepoAndRelatedSecrets retrieves the given repo from its namespace
+// Depending on the repo namespace and the
+func (s *Server) getAppRepoAndRelatedSecrets(ctx context.Context, appRepoName, appRepoNamespace string) (*appRepov1.AppRepository, *corek8sv1.Secret, *corek8sv1.Secret, error) {
+
+	// We currently get app repositories on the kubeapps cluster only.
+	typedClient, dynClient, err := s.GetClients(ctx, s.globalPackagingCluster)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Using the dynamic client to get the AppRepository rather than updating the interface
+	// returned by the client getter, then converting the result back to a typed AppRepository
+	// since our existing code that we're re-using expects one.
+	gvr := schema.GroupVersionResource{
+		Group:    "kubeapps.com",
+		Version:  "v1alpha1",
+		Resource: "apprepositories",
+	}
+	appRepoUnstructured, err := dynClient.Resource(gvr).Namespace(appRepoNamespace).Get(ctx, appRepoName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to get app repository %s/%s: %v", appRepoNamespace, appRepoName, err)
+	}
+
+	var appRepo appRepov1.AppRepository
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(appRepoUnstructured.UnstructuredContent(), &appRepo)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to convert unstructured AppRepository for %s/%s to a structured AppRepository: %v", appRepoNamespace, appRepoName, err)
+	}
+
+	auth := appRepo.Spec.Auth
+	var caCertSecret *corek8sv1.Secret
+	if auth.CustomCA != nil {
+		secretName := auth.CustomCA.SecretKeyRef.Name
+		caCertSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", auth.CustomCA.SecretKeyRef.Name, err)
+		}
+	}
+
+	var authSecret *corek8sv1.Secret
+	if auth.Header != nil {
+		secretName := auth.Header.SecretKeyRef.Name
+		authSecret, err = typedClient.CoreV1().Secrets(appRepoNamespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to read secret %q: %v", secretName, err)
+		}
+	}
+
+	return &appRepo, caCertSecret, authSecret, nil
+}
+
+// WatchInstalledPackages streams the status of the helm releases as it
+// changes, by watching the secrets or configmaps in which helm stores the
+// releases. Releases stored in memory or in a SQL database cannot be watched.
+func (s *Server) WatchInstalledPackages(request *corev1.WatchInstalledPackagesRequest, stream corev1.PackagesService_WatchInstalledPackagesServer) error {
+	ctx := stream.Context()
+	namespace := request.GetContext().GetNamespace()
+	cluster := request.GetContext().GetCluster()
+	labelSelector := "owner=helm"
+	if ref := request.GetInstalledPackageRef(); ref != nil {
+		namespace = ref.GetContext().GetNamespace()
+		cluster = ref.GetContext().GetCluster()
+		labelSelector = fmt.Sprintf("%s,name=%s", labelSelector, ref.GetIdentifier())
+	}
+	contextMsg := fmt.Sprintf("(cluster=[%s], namespace=[%s])", cluster, namespace)
+	log.Infof("+helm WatchInstalledPackages %s", contextMsg)
+	if cluster == "" {
+		cluster = s.globalPackagingCluster
+	}
+
+	typedClient, _, err := s.GetClients(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	listOptions := metav1.ListOptions{LabelSelector: labelSelector}
+	var watcher watch.Interface
+	var storageKind string
+	switch s.storageDriver {
+	case "", "secret", "secrets":
+		storageKind = "secrets"
+		watcher, err = typedClient.CoreV1().Secrets(namespace).Watch(ctx, listOptions)
+	case "configmap", "configmaps":
+		storageKind = "configmaps"
+		watcher, err = typedClient.CoreV1().ConfigMaps(namespace).Watch(ctx, listOptions)
+	default:
+		return status.Errorf(codes.Unimplemented, "Unable to watch the helm releases stored with the %q driver", s.storageDriver)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Unable to watch the helm release %s: %v", storageKind, err)
+	}
+	defer watcher.Stop()
+
+	sender := server.NewInstalledPackageStatusSender(stream)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "The watch of the helm release %s was closed", storageKind)
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "Unable to watch the helm release %s: %v", storageKind, apierrors.FromObject(event.Object))
+			}
+			object, ok := event.Object.(metav1.Object)
+			if !ok {
+				continue
+			}
+
+			// Each revision of a release is stored in its own secret or
+			// configmap, so the status is always that of the latest revision.
+			ref := &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Cluster:   cluster,
+					Namespace: object.GetNamespace(),
+				},
+				Identifier: object.GetLabels()["name"],
+			}
+			actionConfig, err := s.actionConfigGetter(ctx, cluster, object.GetNamespace())
+			if err != nil {
+				return status.Errorf(codes.Internal, "Unable to create Helm action config: %v", err)
+			}
+			rel, err := actionConfig.Releases.Last(ref.Identifier)
+			if errors.Is(err, driver.ErrReleaseNotFound) {
+				err = sender.SendDeleted(ref)
+			} else if err != nil {
+				return status.Errorf(codes.Internal, "Unable to get the release %q: %v", ref.Identifier, err)
+			} else {
+				err = sender.Send(ref, installedPackageStatusFromRelease(rel))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -15,7 +15,9 @@ package main
 
 import (
 	"context"
+	sqldriver "database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -45,8 +47,12 @@ import (
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
@@ -462,7 +468,10 @@ func makeChartRowsJSON(t *testing.T, charts []*models.Chart, pageToken string, p
 		if pageSize == 0 {
 			t.Fatalf("pagesize must be > 0 when using a page token")
 		}
-		rowsJSON = rowsJSON[((pageOffset - 1) * pageSize):]
+		if pageOffset > len(rowsJSON) {
+			pageOffset = len(rowsJSON)
+		}
+		rowsJSON = rowsJSON[pageOffset:]
 	}
 	if pageSize > 0 && pageSize < len(rowsJSON) {
 		rowsJSON = rowsJSON[0:pageSize]
@@ -488,6 +497,21 @@ func makeServer(t *testing.T, authorized bool, actionConfig *action.Configuratio
 
 	// Creating an authorized clientGetter
 	clientSet := typfake.NewSimpleClientset()
+	// The resources of the released manifests, for the discovery of their kinds.
+	clientSet.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "services", Kind: "Service", Namespaced: true}},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+		},
+		{
+			GroupVersion: "rbac.authorization.k8s.io/v1",
+			APIResources: []metav1.APIResource{{Name: "clusterroles", Kind: "ClusterRole"}},
+		},
+	}
 	clientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
 			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: authorized},
@@ -698,17 +722,6 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			},
 			statusCode: codes.OK,
 		},
-		{
-			name:       "it returns a unimplemented status if no namespaces is provided",
-			authorized: true,
-			request: &corev1.GetAvailablePackageSummariesRequest{
-				Context: &corev1.Context{
-					Namespace: "",
-				},
-			},
-			charts:     []*models.Chart{},
-			statusCode: codes.Unimplemented,
-		},
 		{
 			name:       "it returns an internal error status if response does not contain version",
 			authorized: true,
@@ -742,7 +755,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 					Namespace: globalPackagingNamespace,
 				},
 				PaginationOptions: &corev1.PaginationOptions{
-					PageToken: "2",
+					PageToken: "1",
 					PageSize:  1,
 				},
 			},
@@ -771,7 +784,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 						},
 					},
 				},
-				NextPageToken: "3",
+				NextPageToken: "2",
 				Categories:    []string{"cat1"},
 			},
 		},
@@ -783,8 +796,8 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 					Cluster:   "",
 					Namespace: globalPackagingNamespace,
 				},
-				// Start on page two with two results per page, which in this input
-				// corresponds only to the third chart.
+				// Start on the third chart with two results per page, which in this
+				// input corresponds only to the third chart.
 				PaginationOptions: &corev1.PaginationOptions{
 					PageToken: "2",
 					PageSize:  2,
@@ -912,7 +925,7 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			defer cleanup()
 
 			// Simulate the pagination by reducing the rows of JSON based on the offset and limit.
-			// TODO(mnelson): We should check the LIMIT and OFFSET in the actual query as well.
+			// The LIMIT and OFFSET of the actual query are checked in the assetsvc tests.
 			rowsJSON := makeChartRowsJSON(t, tc.charts, tc.request.GetPaginationOptions().GetPageToken(), int(tc.request.GetPaginationOptions().GetPageSize()))
 
 			rows := sqlmock.NewRows([]string{"info"})
@@ -948,12 +961,6 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				mock.ExpectQuery("SELECT info FROM").
 					WithArgs(tc.expectDBQueryNamespace, server.globalPackagingNamespace).
 					WillReturnRows(rows)
-
-				if tc.request.GetPaginationOptions().GetPageSize() > 0 {
-					mock.ExpectQuery("SELECT count").
-						WithArgs(tc.request.Context.Namespace, server.globalPackagingNamespace).
-						WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
-				}
 			}
 
 			availablePackageSummaries, err := server.GetAvailablePackageSummaries(context.Background(), tc.request)
@@ -976,6 +983,107 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 	}
 }
 
+func TestGetAvailablePackageSummariesWithoutNamespace(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		namespaces           []*k8scorev1.Namespace
+		allowedNamespaces    []string
+		forbidListNamespaces bool
+		failAccessReviews    bool
+		expectedDBQueryArgs  []sqldriver.Value
+		expectedStatusCode   codes.Code
+	}{
+		{
+			name: "it queries the global namespace and the namespaces where the user can list app repositories",
+			namespaces: []*k8scorev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: globalPackagingNamespace}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-ns"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-ns"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "your-ns"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "terminating-ns"},
+					Status:     k8scorev1.NamespaceStatus{Phase: k8scorev1.NamespaceTerminating},
+				},
+			},
+			allowedNamespaces:   []string{"your-ns", "my-ns", "terminating-ns"},
+			expectedDBQueryArgs: []sqldriver.Value{globalPackagingNamespace, "my-ns", "your-ns"},
+		},
+		{
+			name: "it queries only the global namespace if the user cannot list namespaces",
+			namespaces: []*k8scorev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-ns"}},
+			},
+			allowedNamespaces:    []string{"my-ns"},
+			forbidListNamespaces: true,
+			expectedDBQueryArgs:  []sqldriver.Value{globalPackagingNamespace},
+		},
+		{
+			name: "it fails if the access to a namespace cannot be checked",
+			namespaces: []*k8scorev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-ns"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "your-ns"}},
+			},
+			allowedNamespaces:  []string{"my-ns", "your-ns"},
+			failAccessReviews:  true,
+			expectedStatusCode: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, mock, cleanup := makeServer(t, false, nil)
+			defer cleanup()
+
+			client, _, err := server.GetClients(context.Background(), "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			for _, ns := range tc.namespaces {
+				if _, err := client.CoreV1().Namespaces().Create(context.Background(), ns, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			fakeClient := client.(*typfake.Clientset)
+			fakeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+				attributes := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).Spec.ResourceAttributes
+				if tc.failAccessReviews && attributes.Namespace == "your-ns" {
+					return true, nil, errors.New("the server is currently unable to handle the request")
+				}
+				allowed := attributes.Resource == "apprepositories" && attributes.Verb == "list"
+				allowed = allowed && sets.NewString(tc.allowedNamespaces...).Has(attributes.Namespace)
+				return true, &authorizationv1.SelfSubjectAccessReview{
+					Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+				}, nil
+			})
+			if tc.forbidListNamespaces {
+				fakeClient.PrependReactor("list", "namespaces", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, k8serrors.NewForbidden(k8scorev1.Resource("namespaces"), "", errors.New("forbidden"))
+				})
+			}
+
+			if tc.expectedStatusCode == codes.OK {
+				mock.ExpectQuery("SELECT (info ->> 'category')*").
+					WithArgs(tc.expectedDBQueryArgs...).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "count"}))
+				mock.ExpectQuery("SELECT info FROM").
+					WithArgs(tc.expectedDBQueryArgs...).
+					WillReturnRows(sqlmock.NewRows([]string{"info"}))
+			}
+
+			_, err = server.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context: &corev1.Context{},
+			})
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestAvailablePackageDetailFromChart(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1578,8 +1686,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-1",
 						},
-						Name:    "my-release-1",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-1",
+						PkgDisplayName: "my-release-1",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "1.2.3",
 						},
@@ -1605,8 +1714,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-3",
 						},
-						Name:    "my-release-3",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-3",
+						PkgDisplayName: "my-release-3",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "4.5.6",
 						},
@@ -1627,6 +1737,62 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns installed packages with the requested statuses",
+			request: &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: "namespace-1"},
+				StatusFilters: []corev1.GetInstalledPackageSummariesRequest_StatusFilter{
+					corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_UNINSTALLED,
+				},
+			},
+			existingReleases: []releaseStub{
+				{
+					name:         "my-release-1",
+					namespace:    "namespace-1",
+					chartVersion: "1.2.3",
+					status:       release.StatusDeployed,
+					version:      1,
+				},
+				{
+					name:         "my-release-2",
+					namespace:    "namespace-1",
+					chartVersion: "3.4.5",
+					status:       release.StatusUninstalled,
+					version:      1,
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.GetInstalledPackageSummariesResponse{
+				InstalledPackageSummaries: []*corev1.InstalledPackageSummary{
+					{
+						InstalledPackageRef: &corev1.InstalledPackageReference{
+							Context: &corev1.Context{
+								Cluster:   globalPackagingCluster,
+								Namespace: "namespace-1",
+							},
+							Identifier: "my-release-2",
+						},
+						Name:           "my-release-2",
+						PkgDisplayName: "my-release-2",
+						IconUrl:        "https://example.com/icon.png",
+						PkgVersionReference: &corev1.VersionReference{
+							Version: "3.4.5",
+						},
+						CurrentVersion: &corev1.PackageAppVersion{
+							PkgVersion: "3.4.5",
+							AppVersion: DefaultAppVersion,
+						},
+						LatestVersion: &corev1.PackageAppVersion{
+							PkgVersion: "3.4.5",
+						},
+						Status: &corev1.InstalledPackageStatus{
+							Reason:     corev1.InstalledPackageStatus_STATUS_REASON_UNSPECIFIED,
+							UserReason: "uninstalled",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "returns installed packages across all namespaces",
 			request: &corev1.GetInstalledPackageSummariesRequest{
@@ -1666,8 +1832,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-1",
 						},
-						Name:    "my-release-1",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-1",
+						PkgDisplayName: "my-release-1",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "1.2.3",
 						},
@@ -1693,8 +1860,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-2",
 						},
-						Name:    "my-release-2",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-2",
+						PkgDisplayName: "my-release-2",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "3.4.5",
 						},
@@ -1720,8 +1888,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-3",
 						},
-						Name:    "my-release-3",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-3",
+						PkgDisplayName: "my-release-3",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "4.5.6",
 						},
@@ -1784,8 +1953,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-1",
 						},
-						Name:    "my-release-1",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-1",
+						PkgDisplayName: "my-release-1",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "1.2.3",
 						},
@@ -1811,8 +1981,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-2",
 						},
-						Name:    "my-release-2",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-2",
+						PkgDisplayName: "my-release-2",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "3.4.5",
 						},
@@ -1831,7 +2002,7 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 						},
 					},
 				},
-				NextPageToken: "3",
+				NextPageToken: "2",
 			},
 		},
 		{
@@ -1877,8 +2048,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-3",
 						},
-						Name:    "my-release-3",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-3",
+						PkgDisplayName: "my-release-3",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "4.5.6",
 						},
@@ -1925,8 +2097,9 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 							},
 							Identifier: "my-release-1",
 						},
-						Name:    "my-release-1",
-						IconUrl: "https://example.com/icon.png",
+						Name:           "my-release-1",
+						PkgDisplayName: "my-release-1",
+						IconUrl:        "https://example.com/icon.png",
 						PkgVersionReference: &corev1.VersionReference{
 							Version: "1.2.3",
 						},
@@ -1984,6 +2157,147 @@ func TestGetInstalledPackageSummaries(t *testing.T) {
 	}
 }
 
+func TestGetInstalledPackageSummariesWithSQLDriver(t *testing.T) {
+	existingReleases := []releaseStub{
+		{
+			name:         "my-release-1",
+			namespace:    "namespace-1",
+			chartVersion: "1.2.3",
+			status:       release.StatusDeployed,
+			version:      1,
+		},
+		{
+			name:         "my-release-2",
+			namespace:    "other-namespace",
+			chartVersion: "1.2.3",
+			status:       release.StatusDeployed,
+			version:      1,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		namespace            string
+		expectedStatusCode   codes.Code
+		expectedReleaseNames []string
+	}{
+		{
+			name:                 "it returns only the releases of the namespaces the user can read",
+			expectedReleaseNames: []string{"my-release-1"},
+		},
+		{
+			name:                 "it returns the releases of a namespace the user can read",
+			namespace:            "namespace-1",
+			expectedReleaseNames: []string{"my-release-1"},
+		},
+		{
+			name:               "it returns unauthenticated for a namespace the user cannot read",
+			namespace:          "other-namespace",
+			expectedStatusCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actionConfig := newActionConfigFixture(t, tc.namespace, existingReleases)
+			server, mock, cleanup := makeServer(t, true, actionConfig)
+			defer cleanup()
+			// The releases stored with the sql driver are read with the
+			// credentials of the server rather than those of the user.
+			server.storageDriver = "sql"
+
+			client, _, err := server.GetClients(context.Background(), "")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			for _, name := range []string{"namespace-1", "other-namespace"} {
+				ns := &k8scorev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				if _, err := client.CoreV1().Namespaces().Create(context.Background(), ns, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+			client.(*typfake.Clientset).PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+				attributes := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview).Spec.ResourceAttributes
+				allowed := attributes.Resource == "secrets" && attributes.Namespace == "namespace-1"
+				return true, &authorizationv1.SelfSubjectAccessReview{
+					Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+				}, nil
+			})
+			if tc.expectedStatusCode == codes.OK {
+				mock.ExpectQuery("SELECT info FROM").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}))
+			}
+
+			response, err := server.GetInstalledPackageSummaries(context.Background(), &corev1.GetInstalledPackageSummariesRequest{
+				Context: &corev1.Context{Namespace: tc.namespace},
+			})
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			releaseNames := []string{}
+			for _, pkg := range response.InstalledPackageSummaries {
+				releaseNames = append(releaseNames, pkg.Name)
+			}
+			if got, want := releaseNames, tc.expectedReleaseNames; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestListStatesForStatusFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filters  []corev1.GetInstalledPackageSummariesRequest_StatusFilter
+		expected action.ListStates
+	}{
+		{
+			name:     "defaults to the deployed and failed releases",
+			expected: action.ListDeployed | action.ListFailed,
+		},
+		{
+			name: "combines the requested statuses",
+			filters: []corev1.GetInstalledPackageSummariesRequest_StatusFilter{
+				corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_DEPLOYED,
+				corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_PENDING,
+			},
+			expected: action.ListDeployed | action.ListPendingInstall | action.ListPendingUpgrade | action.ListPendingRollback,
+		},
+		{
+			name: "maps uninstalled to the releases uninstalled with their history",
+			filters: []corev1.GetInstalledPackageSummariesRequest_StatusFilter{
+				corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_UNINSTALLED,
+			},
+			expected: action.ListUninstalled,
+		},
+		{
+			name: "includes every status for all",
+			filters: []corev1.GetInstalledPackageSummariesRequest_StatusFilter{
+				corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_FAILED,
+				corev1.GetInstalledPackageSummariesRequest_STATUS_FILTER_ALL,
+			},
+			expected: action.ListAll,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := listStatesForStatusFilters(tc.filters), tc.expected; got != want {
+				t.Errorf("got: %b, want: %b", got, want)
+			}
+		})
+	}
+}
+
 func TestGetInstalledPackageDetail(t *testing.T) {
 	customDetailRevision2, err := anypb.New(&helmv1.InstalledPackageDetailCustomDataHelm{
 		ReleaseRevision: 2,
@@ -2126,6 +2440,223 @@ func TestGetInstalledPackageDetail(t *testing.T) {
 	}
 }
 
+func TestAvailableChartForRelease(t *testing.T) {
+	annotatedRelease := &release.Release{
+		Name:      "my-apache",
+		Namespace: "default",
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{
+				Name:    "apache",
+				Version: "1.18.3",
+				Annotations: map[string]string{
+					AppRepositoryNamespaceAnnotation: "my-ns",
+					AppRepositoryNameAnnotation:      "bitnami",
+					ChartIDAnnotation:                "bitnami/apache",
+				},
+			},
+		},
+	}
+	unannotatedRelease := &release.Release{
+		Name:      "my-apache",
+		Namespace: "default",
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{
+				Name:       "apache",
+				Version:    "1.18.3",
+				AppVersion: DefaultAppVersion,
+			},
+		},
+	}
+	chartJSON := func(id, namespace string) string {
+		chartJSON, err := json.Marshal(&models.Chart{
+			ID:            id,
+			Name:          "apache",
+			Repo:          &models.Repo{Namespace: namespace},
+			ChartVersions: []models.ChartVersion{{Version: "1.18.4"}},
+		})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return string(chartJSON)
+	}
+
+	testCases := []struct {
+		name            string
+		release         *release.Release
+		expectQueries   func(mock sqlmock.Sqlmock)
+		expectedChartID string
+	}{
+		{
+			name:    "it returns the chart referenced by the annotations of the release",
+			release: annotatedRelease,
+			expectQueries: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT info FROM charts WHERE repo_namespace = \\$1 AND chart_id = \\$2").
+					WithArgs("my-ns", "bitnami/apache").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(chartJSON("bitnami/apache", "my-ns")))
+			},
+			expectedChartID: "bitnami/apache",
+		},
+		{
+			name:    "it returns no chart if the annotated chart no longer exists",
+			release: annotatedRelease,
+			expectQueries: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT info FROM charts WHERE repo_namespace = \\$1 AND chart_id = \\$2").
+					WithArgs("my-ns", "bitnami/apache").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}))
+				// The fallback query for mirrored charts.
+				mock.ExpectQuery("SELECT info FROM charts WHERE repo_namespace = \\$1 AND chart_id ILIKE \\$2").
+					WithArgs("my-ns", "bitnami%apache").
+					WillReturnRows(sqlmock.NewRows([]string{"info"}))
+			},
+		},
+		{
+			name:    "it looks up a chart by name and version for unannotated releases",
+			release: unannotatedRelease,
+			expectQueries: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT info FROM").
+					WithArgs("default", globalPackagingNamespace, "apache", sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"info"}).AddRow(chartJSON("other-repo/apache", "default")))
+			},
+			expectedChartID: "other-repo/apache",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, mock, cleanup := makeServer(t, true, nil)
+			defer cleanup()
+			tc.expectQueries(mock)
+
+			chart, err := server.availableChartForRelease(tc.release)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			gotChartID := ""
+			if chart != nil {
+				gotChartID = chart.ID
+			}
+			if got, want := gotChartID, tc.expectedChartID; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestLatestChartVersionQueryForRelease(t *testing.T) {
+	testCases := []struct {
+		name          string
+		annotations   map[string]string
+		expectedQuery utils.LatestChartVersionQuery
+	}{
+		{
+			name: "it queries the chart referenced by the annotations of the release",
+			annotations: map[string]string{
+				AppRepositoryNamespaceAnnotation: "my-ns",
+				AppRepositoryNameAnnotation:      "bitnami",
+				ChartIDAnnotation:                "bitnami/apache",
+			},
+			expectedQuery: utils.LatestChartVersionQuery{Namespace: "my-ns", ChartID: "bitnami/apache"},
+		},
+		{
+			name:          "it queries the chart by name and version in the release namespace without annotations",
+			expectedQuery: utils.LatestChartVersionQuery{Namespace: "default", ChartName: "apache", Version: "1.18.3", AppVersion: "2.4.48"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rel := &release.Release{
+				Name:      "my-apache",
+				Namespace: "default",
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:        "apache",
+						Version:     "1.18.3",
+						AppVersion:  "2.4.48",
+						Annotations: tc.annotations,
+					},
+				},
+			}
+
+			if got, want := latestChartVersionQueryForRelease(rel), tc.expectedQuery; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLatestMatchingVersion(t *testing.T) {
+	chartVersions := []models.ChartVersion{
+		{Version: "1.5.0", AppVersion: "2.5.0"},
+		{Version: "1.4.2", AppVersion: "2.4.2"},
+		{Version: "1.4.1", AppVersion: "2.4.1"},
+		{Version: "1.3.0", AppVersion: "2.3.0"},
+	}
+
+	testCases := []struct {
+		name                    string
+		constraint              string
+		currentVersion          string
+		expectedMatchingVersion *corev1.PackageAppVersion
+	}{
+		{
+			name:                    "it returns the newest version satisfying the constraint",
+			constraint:              "~1.4",
+			currentVersion:          "1.4.1",
+			expectedMatchingVersion: &corev1.PackageAppVersion{PkgVersion: "1.4.2", AppVersion: "2.4.2"},
+		},
+		{
+			name:           "it returns nil when the current version is the newest satisfying the constraint",
+			constraint:     "~1.4",
+			currentVersion: "1.4.2",
+		},
+		{
+			name:           "it returns nil when only older versions satisfy the constraint",
+			constraint:     "<1.4",
+			currentVersion: "1.4.1",
+		},
+		{
+			name:           "it returns nil without a constraint",
+			currentVersion: "1.4.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			annotations := map[string]string{}
+			if tc.constraint != "" {
+				annotations[VersionConstraintAnnotation] = tc.constraint
+			}
+			rel := &release.Release{
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:        "apache",
+						Version:     tc.currentVersion,
+						Annotations: annotations,
+					},
+				},
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.PackageAppVersion{})
+			if got, want := latestMatchingVersion(rel, chartVersions), tc.expectedMatchingVersion; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+			expectedVersionReference := tc.constraint
+			if expectedVersionReference == "" {
+				expectedVersionReference = tc.currentVersion
+			}
+			if got, want := pkgVersionReferenceForRelease(rel).GetVersion(), expectedVersionReference; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
 // newActionConfigFixture returns an action.Configuration with fake clients
 // and memory storage.
 func newActionConfigFixture(t *testing.T, namespace string, rels []releaseStub) *action.Configuration {
@@ -2165,6 +2696,10 @@ func releaseForStub(t *testing.T, r releaseStub) *release.Release {
 			t.Fatalf("%+v", err)
 		}
 	}
+	var annotations map[string]string
+	if r.versionConstraint != "" {
+		annotations = map[string]string{VersionConstraintAnnotation: r.versionConstraint}
+	}
 	return &release.Release{
 		Name:      r.name,
 		Namespace: r.namespace,
@@ -2175,12 +2710,16 @@ func releaseForStub(t *testing.T, r releaseStub) *release.Release {
 		},
 		Chart: &chart.Chart{
 			Metadata: &chart.Metadata{
-				Version:    r.chartVersion,
-				Icon:       "https://example.com/icon.png",
-				AppVersion: DefaultAppVersion,
+				Name:        r.name,
+				Version:     r.chartVersion,
+				Icon:        "https://example.com/icon.png",
+				AppVersion:  DefaultAppVersion,
+				Annotations: annotations,
 			},
 		},
-		Config: config,
+		Config:   config,
+		Manifest: r.manifest,
+		Hooks:    r.hooks,
 	}
 }
 
@@ -2224,19 +2763,24 @@ func chartAssetForReleaseStub(rel *releaseStub) *models.Chart {
 }
 
 func populateAssetDBWithSummaries(t *testing.T, mock sqlmock.Sqlmock, pkgs []*corev1.InstalledPackageSummary) {
-	// The code currently executes one query per release in the paginated
-	// results and should receive a single row response.
-	rels := []*releaseStub{}
+	// The code executes a single query for all the releases in the paginated
+	// results and should receive a row for each of them.
+	rows := sqlmock.NewRows([]string{"info"})
 	for _, pkg := range pkgs {
-		rels = append(rels, &releaseStub{
+		chartJSON, err := json.Marshal(chartAssetForReleaseStub(&releaseStub{
 			name:          pkg.Name,
 			namespace:     pkg.GetInstalledPackageRef().GetContext().GetNamespace(),
 			chartVersion:  pkg.CurrentVersion.PkgVersion,
 			latestVersion: pkg.LatestVersion.PkgVersion,
 			version:       DefaultReleaseRevision,
-		})
+		}))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		rows.AddRow(string(chartJSON))
 	}
-	populateAssetDB(t, mock, rels)
+	mock.ExpectQuery("SELECT info FROM").
+		WillReturnRows(rows)
 }
 
 func populateAssetDBWithDetail(t *testing.T, mock sqlmock.Sqlmock, pkg *corev1.InstalledPackageDetail) {
@@ -2276,5 +2820,10 @@ type releaseStub struct {
 	latestVersion string
 	values        string
 	notes         string
+	manifest      string
 	status        release.Status
+	hooks         []*release.Hook
+	// versionConstraint is the constraint recorded when the release was
+	// installed with a semver range.
+	versionConstraint string
 }
@@ -0,0 +1,184 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	helmv1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/helm/packages/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func apacheWithTestsStubs() []releaseStub {
+	return []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      1,
+			hooks: []*release.Hook{
+				{
+					Name:   "my-apache-test-connection",
+					Kind:   "Pod",
+					Events: []release.HookEvent{release.HookTest},
+				},
+				{
+					Name:   "my-apache-test-job",
+					Kind:   "Job",
+					Events: []release.HookEvent{release.HookTest},
+				},
+				{
+					Name:   "my-apache-post-install",
+					Kind:   "Job",
+					Events: []release.HookEvent{release.HookPostInstall},
+				},
+			},
+		},
+	}
+}
+
+func TestRunInstalledPackageTests(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		existingReleases     []releaseStub
+		watchUntilReadyError error
+		request              *helmv1.RunInstalledPackageTestsRequest
+		expectedStatusCode   codes.Code
+		expectedResponse     *helmv1.RunInstalledPackageTestsResponse
+	}{
+		{
+			name:             "runs the tests of the installed package",
+			existingReleases: apacheWithTestsStubs(),
+			request: &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &helmv1.RunInstalledPackageTestsResponse{
+				TestResults: []*helmv1.InstalledPackageTestResult{
+					{
+						Name:  "my-apache-test-connection",
+						Phase: helmv1.InstalledPackageTestResult_TEST_PHASE_SUCCEEDED,
+						Logs:  "fake logs",
+					},
+					{
+						// Only the logs of the pods are returned.
+						Name:  "my-apache-test-job",
+						Phase: helmv1.InstalledPackageTestResult_TEST_PHASE_SUCCEEDED,
+					},
+				},
+			},
+		},
+		{
+			name:                 "returns the failed tests of the installed package",
+			existingReleases:     apacheWithTestsStubs(),
+			watchUntilReadyError: errors.New("pod my-apache-test-connection failed"),
+			request: &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				TimeoutSeconds: 30,
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &helmv1.RunInstalledPackageTestsResponse{
+				TestResults: []*helmv1.InstalledPackageTestResult{
+					{
+						Name:  "my-apache-test-connection",
+						Phase: helmv1.InstalledPackageTestResult_TEST_PHASE_FAILED,
+						Logs:  "fake logs",
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+		{
+			name:             "returns invalid if the timeout is negative",
+			existingReleases: apacheWithTestsStubs(),
+			request: &helmv1.RunInstalledPackageTestsRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				TimeoutSeconds: -1,
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			actionConfig.KubeClient.(*kubefake.FailingKubeClient).WatchUntilReadyError = tc.watchUntilReadyError
+			server, _, cleanup := makeServer(t, authorized, actionConfig)
+			defer cleanup()
+
+			response, err := server.RunInstalledPackageTests(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			opts := protocmp.IgnoreFields(&helmv1.InstalledPackageTestResult{}, "started_at", "completed_at")
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, protocmp.Transform(), opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, protocmp.Transform(), opts))
+			}
+
+			for _, result := range response.GetTestResults() {
+				if result.StartedAt == nil || result.CompletedAt == nil {
+					t.Errorf("got: %+v, want: started and completed times", result)
+				}
+			}
+		})
+	}
+}
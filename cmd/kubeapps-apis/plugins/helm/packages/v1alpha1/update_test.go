@@ -0,0 +1,366 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kubeapps/kubeapps/cmd/apprepository-controller/pkg/apis/apprepository/v1alpha1"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	chartutils "github.com/kubeapps/kubeapps/pkg/chart"
+	"github.com/kubeapps/kubeapps/pkg/chart/fake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		existingReleases   []releaseStub
+		request            *corev1.UpdateInstalledPackageRequest
+		expectedResponse   *corev1.UpdateInstalledPackageResponse
+		expectedStatusCode codes.Code
+		expectedRelease    *release.Release
+	}{
+		{
+			name: "updates the installed package from repo without credentials",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+				},
+			},
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+				Values: "{\"foo\": \"baz\"}",
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRelease: &release.Release{
+				Name: "my-apache",
+				Info: &release.Info{
+					Description: "Upgrade complete",
+					Status:      release.StatusDeployed,
+				},
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:    "apache",
+						Version: "1.18.4",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: "default",
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+						},
+					},
+					Values: map[string]interface{}{},
+				},
+				Config:    map[string]interface{}{"foo": "baz"},
+				Version:   2,
+				Namespace: "default",
+			},
+		},
+		{
+			name: "keeps the version of the installed package when only its values are updated",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+					values:       "{\"foo\": \"bar\"}",
+				},
+			},
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				Values: "{\"foo\": \"baz\"}",
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRelease: &release.Release{
+				Name: "my-apache",
+				Info: &release.Info{
+					Description: "Upgrade complete",
+					Status:      release.StatusDeployed,
+				},
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:    "apache",
+						Version: "1.18.3",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: "default",
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+						},
+					},
+					Values: map[string]interface{}{},
+				},
+				Config:    map[string]interface{}{"foo": "baz"},
+				Version:   2,
+				Namespace: "default",
+			},
+		},
+		{
+			name: "keeps the version constraint of the installed package when its version is not updated",
+			existingReleases: []releaseStub{
+				{
+					name:              "my-apache",
+					namespace:         "default",
+					chartID:           "bitnami/apache",
+					chartVersion:      "1.18.3",
+					versionConstraint: "~1.18",
+					status:            release.StatusDeployed,
+					version:           1,
+				},
+			},
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				Values: "{\"foo\": \"baz\"}",
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRelease: &release.Release{
+				Name: "my-apache",
+				Info: &release.Info{
+					Description: "Upgrade complete",
+					Status:      release.StatusDeployed,
+				},
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:    "apache",
+						Version: "1.18.3",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: "default",
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+							VersionConstraintAnnotation:      "~1.18",
+						},
+					},
+					Values: map[string]interface{}{},
+				},
+				Config:    map[string]interface{}{"foo": "baz"},
+				Version:   2,
+				Namespace: "default",
+			},
+		},
+		{
+			name: "keeps the values of the installed package when only its version is updated",
+			existingReleases: []releaseStub{
+				{
+					name:         "my-apache",
+					namespace:    "default",
+					chartID:      "bitnami/apache",
+					chartVersion: "1.18.3",
+					status:       release.StatusDeployed,
+					version:      1,
+					values:       "{\"foo\": \"bar\"}",
+				},
+			},
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.18.4",
+				},
+			},
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Cluster:   "default",
+						Namespace: "default",
+					},
+					Identifier: "my-apache",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedRelease: &release.Release{
+				Name: "my-apache",
+				Info: &release.Info{
+					Description: "Upgrade complete",
+					Status:      release.StatusDeployed,
+				},
+				Chart: &chart.Chart{
+					Metadata: &chart.Metadata{
+						Name:    "apache",
+						Version: "1.18.4",
+						Annotations: map[string]string{
+							AppRepositoryNamespaceAnnotation: "default",
+							AppRepositoryNameAnnotation:      "bitnami",
+							ChartIDAnnotation:                "bitnami/apache",
+						},
+					},
+					Values: map[string]interface{}{},
+				},
+				Config:    map[string]interface{}{"foo": "bar"},
+				Version:   2,
+				Namespace: "default",
+			},
+		},
+		{
+			name: "returns not found if installed package doesn't exist",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid if installed package ref incomplete",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-apache",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		corev1.UpdateInstalledPackageResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		plugins.Plugin{},
+		chart.Chart{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorized := true
+			actionConfig := newActionConfigFixture(t, tc.request.GetInstalledPackageRef().GetContext().GetNamespace(), tc.existingReleases)
+			server, mock, cleanup := makeServer(t, authorized, actionConfig, &v1alpha1.AppRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bitnami",
+					Namespace: "default",
+				},
+			})
+			defer cleanup()
+			server.chartClientFactory = &versionedChartClientFactory{}
+
+			if tc.expectedStatusCode == codes.OK {
+				populateAssetDB(t, mock, []*releaseStub{&tc.existingReleases[0]})
+			}
+
+			response, err := server.UpdateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// Verify the expected response (our contract to the caller).
+			if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+			}
+
+			if tc.expectedRelease != nil {
+				// Verify the expected request was made to Helm (our contract to the helm lib).
+				rel, err := actionConfig.Releases.Last(tc.expectedRelease.Name)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				ignoredFields := cmpopts.IgnoreFields(release.Info{}, "FirstDeployed", "LastDeployed")
+				if got, want := rel, tc.expectedRelease; !cmp.Equal(got, want, ignoredUnexported, ignoredFields) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported, ignoredFields))
+				}
+			}
+		})
+	}
+}
+
+// versionedChartClient is a fake chart client which, unlike fake.ChartClient,
+// returns the chart at the requested version.
+type versionedChartClient struct {
+	fake.ChartClient
+}
+
+func (c *versionedChartClient) GetChart(details *chartutils.Details, repoURL string) (*chart.Chart, error) {
+	ch, err := c.ChartClient.GetChart(details, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	ch.Metadata.Version = details.Version
+	return ch, nil
+}
+
+type versionedChartClientFactory struct{}
+
+func (f *versionedChartClientFactory) New(repoType, userAgent string) chartutils.ChartClient {
+	return &versionedChartClient{}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"helm.sh/helm/v3/pkg/release"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	typfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// fakeWatchStream collects the responses sent on a WatchInstalledPackages stream.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackagesResponse
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(response *corev1.WatchInstalledPackagesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+// releaseSecret returns the secret in which helm stores the first revision
+// of a release. The watch only uses its labels to find the release.
+func releaseSecret(name, namespace string) *k8scorev1.Secret {
+	return &k8scorev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1." + name + ".v1",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    name,
+				"version": "1",
+			},
+		},
+	}
+}
+
+func TestWatchInstalledPackages(t *testing.T) {
+	actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.3",
+			status:       release.StatusPendingInstall,
+			version:      1,
+		},
+	})
+	server, _, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+
+	// Control the events of the watch on the release secrets.
+	fakeWatcher := watch.NewFake()
+	clientSet, _, err := server.GetClients(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	clientSet.(*typfake.Clientset).PrependWatchReactor("secrets", k8stesting.DefaultWatchReactor(fakeWatcher, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, responses: make(chan *corev1.WatchInstalledPackagesResponse, 10)}
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- server.WatchInstalledPackages(&corev1.WatchInstalledPackagesRequest{
+			Context: &corev1.Context{Namespace: "default"},
+		}, stream)
+	}()
+
+	ref := &corev1.InstalledPackageReference{
+		Context: &corev1.Context{
+			Cluster:   globalPackagingCluster,
+			Namespace: "default",
+		},
+		Identifier: "my-apache",
+	}
+	pendingStatus := &corev1.InstalledPackageStatus{
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+		UserReason: "pending-install",
+	}
+	deployedStatus := &corev1.InstalledPackageStatus{
+		Ready:      true,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		UserReason: "deployed",
+	}
+
+	// The current status is sent when the release is first seen, and an
+	// unchanged status is not sent again.
+	fakeWatcher.Add(releaseSecret("my-apache", "default"))
+	fakeWatcher.Modify(releaseSecret("my-apache", "default"))
+
+	// The status is sent when the release is deployed.
+	rel, err := actionConfig.Releases.Last("my-apache")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	rel.Info.Status = release.StatusDeployed
+	if err = actionConfig.Releases.Update(rel); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fakeWatcher.Modify(releaseSecret("my-apache", "default"))
+
+	// The deletion is sent with the last status when the release is uninstalled.
+	if _, err = actionConfig.Releases.Delete("my-apache", 1); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	fakeWatcher.Delete(releaseSecret("my-apache", "default"))
+
+	expectedResponses := []*corev1.WatchInstalledPackagesResponse{
+		{InstalledPackageRef: ref, Status: pendingStatus},
+		{InstalledPackageRef: ref, Status: deployedStatus},
+		{InstalledPackageRef: ref, Status: deployedStatus, Deleted: true},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackagesResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.InstalledPackageStatus{},
+	)
+	for _, want := range expectedResponses {
+		select {
+		case got := <-stream.responses:
+			if !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %+v", want)
+		}
+	}
+
+	cancel()
+	if err := <-watchErr; err != nil {
+		t.Errorf("%+v", err)
+	}
+	if len(stream.responses) > 0 {
+		t.Errorf("unexpected response: %+v", <-stream.responses)
+	}
+}
+
+func TestWatchInstalledPackagesWithConfigMapsDriver(t *testing.T) {
+	actionConfig := newActionConfigFixture(t, "default", []releaseStub{
+		{
+			name:         "my-apache",
+			namespace:    "default",
+			chartID:      "bitnami/apache",
+			chartVersion: "1.18.3",
+			status:       release.StatusDeployed,
+			version:      1,
+		},
+	})
+	server, _, cleanup := makeServer(t, true, actionConfig)
+	defer cleanup()
+	server.storageDriver = "configmaps"
+
+	// Control the events of the watch on the release configmaps.
+	fakeWatcher := watch.NewFake()
+	clientSet, _, err := server.GetClients(context.Background(), "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	clientSet.(*typfake.Clientset).PrependWatchReactor("configmaps", k8stesting.DefaultWatchReactor(fakeWatcher, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, responses: make(chan *corev1.WatchInstalledPackagesResponse, 10)}
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- server.WatchInstalledPackages(&corev1.WatchInstalledPackagesRequest{
+			Context: &corev1.Context{Namespace: "default"},
+		}, stream)
+	}()
+
+	secret := releaseSecret("my-apache", "default")
+	fakeWatcher.Add(&k8scorev1.ConfigMap{ObjectMeta: secret.ObjectMeta})
+
+	want := &corev1.WatchInstalledPackagesResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   globalPackagingCluster,
+				Namespace: "default",
+			},
+			Identifier: "my-apache",
+		},
+		Status: &corev1.InstalledPackageStatus{
+			Ready:      true,
+			Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+			UserReason: "deployed",
+		},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackagesResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.InstalledPackageStatus{},
+	)
+	select {
+	case got := <-stream.responses:
+		if !cmp.Equal(want, got, opts) {
+			t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for %+v", want)
+	}
+
+	cancel()
+	if err := <-watchErr; err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+func TestWatchInstalledPackagesWithUnwatchableDriver(t *testing.T) {
+	for _, storageDriver := range []string{"memory", "sql"} {
+		t.Run(storageDriver, func(t *testing.T) {
+			server, _, cleanup := makeServer(t, true, nil)
+			defer cleanup()
+			server.storageDriver = storageDriver
+
+			stream := &fakeWatchStream{ctx: context.Background(), responses: make(chan *corev1.WatchInstalledPackagesResponse, 10)}
+			err := server.WatchInstalledPackages(&corev1.WatchInstalledPackagesRequest{
+				Context: &corev1.Context{Namespace: "default"},
+			}, stream)
+			if got, want := status.Code(err), codes.Unimplemented; got != want {
+				t.Errorf("got: %+v, want: %+v", got, want)
+			}
+		})
+	}
+}
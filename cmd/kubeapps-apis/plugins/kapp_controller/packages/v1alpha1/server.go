@@ -15,6 +15,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	// v1 "github.com/kubeapps/kubeapps/cmd/kubeapps-api-service/kubeappsapis/core/packagerepositories/v1"
 	// *sigh*, seems different versions of the k8s client.go (at the time of writing, kapp-controller
@@ -25,10 +28,15 @@ import (
 		        want (context.Context)
 	*/
 	// So instead we use the dynamic (untyped) client.
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 
 	log "k8s.io/klog/v2"
 
@@ -40,6 +48,7 @@ import (
 )
 
 type clientGetter func(context.Context) (dynamic.Interface, error)
+type discoveryClientGetter func(context.Context) (discovery.DiscoveryInterface, error)
 
 const (
 	packagingGroup = "packaging.carvel.dev"
@@ -55,10 +64,40 @@ const (
 	repositoriesResource  = "packagerepositories"
 
 	globalPackagingNamespace = "kapp-controller-packaging-global"
+
+	// See https://carvel.dev/kapp-controller/docs/latest/app-spec/
+	appGroup     = "kappctrl.k14s.io"
+	appVersion   = "v1alpha1"
+	appsResource = "apps"
 )
 
+// wellKnownKindAPIVersions maps the kinds of the common resources deployed by
+// packages to their api version.
+var wellKnownKindAPIVersions = map[string]string{
+	"ClusterRole":              "rbac.authorization.k8s.io/v1",
+	"ClusterRoleBinding":       "rbac.authorization.k8s.io/v1",
+	"ConfigMap":                "v1",
+	"CronJob":                  "batch/v1",
+	"CustomResourceDefinition": "apiextensions.k8s.io/v1",
+	"DaemonSet":                "apps/v1",
+	"Deployment":               "apps/v1",
+	"Ingress":                  "networking.k8s.io/v1",
+	"Job":                      "batch/v1",
+	"Namespace":                "v1",
+	"NetworkPolicy":            "networking.k8s.io/v1",
+	"PersistentVolumeClaim":    "v1",
+	"Pod":                      "v1",
+	"ReplicaSet":               "apps/v1",
+	"Role":                     "rbac.authorization.k8s.io/v1",
+	"RoleBinding":              "rbac.authorization.k8s.io/v1",
+	"Secret":                   "v1",
+	"Service":                  "v1",
+	"ServiceAccount":           "v1",
+	"StatefulSet":              "apps/v1",
+}
+
 // Compile-time statement to ensure this service implementation satisfies the core packaging API
-var _ corev1.PackagesServiceServer = (*Server)(nil)
+var _ server.PackagesPluginServer = (*Server)(nil)
 
 // Server implements the kapp-controller packages v1alpha1 interface.
 type Server struct {
@@ -67,22 +106,32 @@ type Server struct {
 	// a fake client. NewServer() below sets this automatically with the
 	// non-test implementation.
 	clientGetter clientGetter
+	// discoveryClientGetter is used to map the kinds of the deployed
+	// resources to the resources served by the cluster.
+	discoveryClientGetter discoveryClientGetter
 }
 
 // NewServer returns a Server automatically configured with a function to obtain
 // the k8s client config.
 func NewServer(configGetter server.KubernetesConfigGetter) *Server {
+	getConfig := func(ctx context.Context) (*rest.Config, error) {
+		if configGetter == nil {
+			return nil, status.Errorf(codes.Internal, "configGetter arg required")
+		}
+		// The Kapp Controller plugin currently supports interactions with
+		// the default (kubeapps) cluster only:
+		cluster := ""
+		config, err := configGetter(ctx, cluster)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get config : %v", err))
+		}
+		return config, nil
+	}
 	return &Server{
 		clientGetter: func(ctx context.Context) (dynamic.Interface, error) {
-			if configGetter == nil {
-				return nil, status.Errorf(codes.Internal, "configGetter arg required")
-			}
-			// The Kapp Controller plugin currently supports interactions with
-			// the default (kubeapps) cluster only:
-			cluster := ""
-			config, err := configGetter(ctx, cluster)
+			config, err := getConfig(ctx)
 			if err != nil {
-				return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get config : %v", err))
+				return nil, err
 			}
 			dynamicClient, err := dynamic.NewForConfig(config)
 			if err != nil {
@@ -90,6 +139,17 @@ func NewServer(configGetter server.KubernetesConfigGetter) *Server {
 			}
 			return dynamicClient, nil
 		},
+		discoveryClientGetter: func(ctx context.Context) (discovery.DiscoveryInterface, error) {
+			config, err := getConfig(ctx)
+			if err != nil {
+				return nil, err
+			}
+			discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+			if err != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get discovery client : %v", err))
+			}
+			return discoveryClient, nil
+		},
 	}
 }
 
@@ -114,6 +174,12 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 
 	log.Infof("+kapp_controller GetAvailablePackageSummaries %s", contextMsg)
 
+	pageSize := request.GetPaginationOptions().GetPageSize()
+	pageOffset, err := pageOffsetFromPageToken(request.GetPaginationOptions().GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
 	namespace := ""
 	if request.Context != nil {
 		if request.Context.Cluster != "" {
@@ -144,11 +210,39 @@ func (s *Server) GetAvailablePackageSummaries(ctx context.Context, request *core
 		}
 		responsePackages = append(responsePackages, pkg)
 	}
+
+	// The packages are listed without any particular order, so sort them
+	// before returning the requested page.
+	server.SortAvailablePackageSummaries(responsePackages, request.GetSortOptions())
+	if pageOffset > len(responsePackages) {
+		pageOffset = len(responsePackages)
+	}
+	responsePackages = responsePackages[pageOffset:]
+	nextPageToken := ""
+	if pageSize > 0 && len(responsePackages) > int(pageSize) {
+		responsePackages = responsePackages[:pageSize]
+		nextPageToken = fmt.Sprintf("%d", pageOffset+int(pageSize))
+	}
+
 	return &corev1.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: responsePackages,
+		NextPageToken:             nextPageToken,
 	}, nil
 }
 
+// pageOffsetFromPageToken converts a page token to an integer offset
+// representing the next item of the results.
+func pageOffsetFromPageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	offset, err := strconv.ParseUint(pageToken, 10, 0)
+	if err != nil {
+		return 0, err
+	}
+	return int(offset), nil
+}
+
 func AvailablePackageSummaryFromUnstructured(ap *unstructured.Unstructured) (*corev1.AvailablePackageSummary, error) {
 	pkg := &corev1.AvailablePackageSummary{}
 
@@ -257,3 +351,470 @@ func packageRepositoryFromUnstructured(pr *unstructured.Unstructured) (*v1alpha1
 	repo.Url = url
 	return repo, nil
 }
+
+// UpdateInstalledPackage updates an installed package based on the request.
+func (s *Server) UpdateInstalledPackage(ctx context.Context, request *corev1.UpdateInstalledPackageRequest) (*corev1.UpdateInstalledPackageResponse, error) {
+	installedPackageRef := request.GetInstalledPackageRef()
+	if installedPackageRef == nil || installedPackageRef.GetContext() == nil || installedPackageRef.GetIdentifier() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	namespace := installedPackageRef.GetContext().GetNamespace()
+	cluster := installedPackageRef.GetContext().GetCluster()
+	name := installedPackageRef.GetIdentifier()
+
+	log.Infof("+kapp_controller UpdateInstalledPackage (cluster=[%s], namespace=[%s], name=[%s])", cluster, namespace, name)
+
+	if cluster != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster)
+	}
+	if len(request.GetValueSources()) > 0 {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.ValueSources")
+	}
+
+	client, err := s.getDynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	packageInstallResource := schema.GroupVersionResource{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}
+	resourceIfc := client.Resource(packageInstallResource).Namespace(namespace)
+
+	pkgInstall, err := resourceIfc.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "unable to find the PackageInstall %q in the namespace %q: %v", name, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "unable to get the PackageInstall %q in the namespace %q: %v", name, namespace, err)
+	}
+
+	// https://carvel.dev/kapp-controller/docs/latest/packaging/#packageinstall-cr
+	if version := request.GetPkgVersionReference().GetVersion(); version != "" {
+		err = unstructured.SetNestedField(pkgInstall.Object, version, "spec", "packageRef", "versionSelection", "constraints")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to set the version of the PackageInstall %q: %v", name, err)
+		}
+	}
+
+	if reconcile := request.GetReconciliationOptions(); reconcile != nil {
+		if err = setReconciliationOptions(pkgInstall.Object, reconcile); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.GetValues() != "" {
+		secretName, err := s.createOrUpdateValuesSecret(ctx, client, pkgInstall, request.GetValues())
+		if err != nil {
+			return nil, err
+		}
+		values := []interface{}{
+			map[string]interface{}{
+				"secretRef": map[string]interface{}{
+					"name": secretName,
+				},
+			},
+		}
+		err = unstructured.SetNestedSlice(pkgInstall.Object, values, "spec", "values")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to set the values of the PackageInstall %q: %v", name, err)
+		}
+	}
+
+	updated, err := resourceIfc.Update(ctx, pkgInstall, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to update the PackageInstall %q in the namespace %q: %v", name, namespace, err)
+	}
+
+	return &corev1.UpdateInstalledPackageResponse{
+		InstalledPackageRef: &corev1.InstalledPackageReference{
+			Context: &corev1.Context{
+				Cluster:   cluster,
+				Namespace: updated.GetNamespace(),
+			},
+			Identifier: updated.GetName(),
+			Plugin:     GetPluginDetail(),
+		},
+	}, nil
+}
+
+// DeleteInstalledPackage deletes an installed package based on the request.
+func (s *Server) DeleteInstalledPackage(ctx context.Context, request *corev1.DeleteInstalledPackageRequest) (*corev1.DeleteInstalledPackageResponse, error) {
+	installedPackageRef := request.GetInstalledPackageRef()
+	if installedPackageRef == nil || installedPackageRef.GetContext() == nil || installedPackageRef.GetIdentifier() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	namespace := installedPackageRef.GetContext().GetNamespace()
+	cluster := installedPackageRef.GetContext().GetCluster()
+	name := installedPackageRef.GetIdentifier()
+
+	log.Infof("+kapp_controller DeleteInstalledPackage (cluster=[%s], namespace=[%s], name=[%s])", cluster, namespace, name)
+
+	if cluster != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster)
+	}
+
+	client, err := s.getDynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// kapp-controller removes the resources of the package once the
+	// PackageInstall is deleted; it does not keep any history around.
+	packageInstallResource := schema.GroupVersionResource{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}
+	err = client.Resource(packageInstallResource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "unable to find the PackageInstall %q in the namespace %q: %v", name, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "unable to delete the PackageInstall %q in the namespace %q: %v", name, namespace, err)
+	}
+
+	// the values secret is owned by the PackageInstall, so it would be garbage
+	// collected eventually, but there is no reason to keep it around until then.
+	if err = deleteValuesSecret(ctx, client, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return &corev1.DeleteInstalledPackageResponse{}, nil
+}
+
+// GetInstalledPackageResourceRefs returns the references for the Kubernetes
+// resources deployed by the kapp-controller App of an installed package.
+func (s *Server) GetInstalledPackageResourceRefs(ctx context.Context, request *corev1.GetInstalledPackageResourceRefsRequest) (*corev1.GetInstalledPackageResourceRefsResponse, error) {
+	installedPackageRef := request.GetInstalledPackageRef()
+	if installedPackageRef == nil || installedPackageRef.GetContext() == nil || installedPackageRef.GetIdentifier() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	namespace := installedPackageRef.GetContext().GetNamespace()
+	cluster := installedPackageRef.GetContext().GetCluster()
+	name := installedPackageRef.GetIdentifier()
+
+	log.Infof("+kapp_controller GetInstalledPackageResourceRefs (cluster=[%s], namespace=[%s], name=[%s])", cluster, namespace, name)
+
+	if cluster != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster)
+	}
+
+	client, err := s.getDynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := appResourceRefs(ctx, client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.GetInstalledPackageResourceRefsResponse{
+		ResourceRefs: refs,
+	}, nil
+}
+
+// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes
+// resources deployed by the kapp-controller App of an installed package.
+func (s *Server) GetInstalledPackageResourceStatuses(ctx context.Context, request *corev1.GetInstalledPackageResourceStatusesRequest) (*corev1.GetInstalledPackageResourceStatusesResponse, error) {
+	installedPackageRef := request.GetInstalledPackageRef()
+	if installedPackageRef == nil || installedPackageRef.GetContext() == nil || installedPackageRef.GetIdentifier() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Required context or identifier not provided")
+	}
+	namespace := installedPackageRef.GetContext().GetNamespace()
+	cluster := installedPackageRef.GetContext().GetCluster()
+	name := installedPackageRef.GetIdentifier()
+
+	log.Infof("+kapp_controller GetInstalledPackageResourceStatuses (cluster=[%s], namespace=[%s], name=[%s])", cluster, namespace, name)
+
+	if cluster != "" {
+		return nil, status.Errorf(codes.Unimplemented, "Not supported yet: request.InstalledPackageRef.Context.Cluster: [%v]", cluster)
+	}
+
+	client, err := s.getDynamicClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := appResourceRefs(ctx, client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.discoveryClientGetter == nil {
+		return nil, status.Errorf(codes.Internal, "server not configured with configGetter")
+	}
+	discoveryClient, err := s.discoveryClientGetter(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("unable to get client : %v", err))
+	}
+
+	return &corev1.GetInstalledPackageResourceStatusesResponse{
+		ResourceStatuses: server.GetResourceStatuses(ctx, client, server.NewResourceMapper(discoveryClient), refs),
+	}, nil
+}
+
+// appResourceRefs returns the references for the resources deployed by the
+// App which kapp-controller creates, with the same name, for a PackageInstall.
+// kapp-controller does not expose the manifest, so the resources are read from
+// the output of the kapp inspection reported in the App status.
+func appResourceRefs(ctx context.Context, client dynamic.Interface, namespace, name string) ([]*corev1.ResourceRef, error) {
+	appResource := schema.GroupVersionResource{Group: appGroup, Version: appVersion, Resource: appsResource}
+	app, err := client.Resource(appResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "unable to find the App %q in the namespace %q: %v", name, namespace, err)
+		}
+		return nil, status.Errorf(codes.Internal, "unable to get the App %q in the namespace %q: %v", name, namespace, err)
+	}
+
+	inspectOutput, _, err := unstructured.NestedString(app.Object, "status", "inspect", "stdout")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to read the inspection of the App %q: %v", name, err)
+	}
+	return resourceRefsFromInspectOutput(inspectOutput), nil
+}
+
+// resourceRefsFromInspectOutput parses the resources table of the output of
+// `kapp inspect`, such as:
+//
+//	Namespace  Name        Kind        Owner  Conds.  Rs  Ri  Age
+//	default    simple-app  Deployment  kapp   2/2 t   ok  -   1m
+//	^          simple-app  Service     kapp   -       ok  -   1m
+//
+// where "^" stands for the namespace of the previous row and cluster-scoped
+// resources have the namespace "(cluster)". The table has no api versions, so
+// only those of well-known kinds are set.
+func resourceRefsFromInspectOutput(output string) []*corev1.ResourceRef {
+	refs := []*corev1.ResourceRef{}
+	inTable := false
+	namespace := ""
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if !inTable {
+			inTable = len(fields) > 0 && fields[0] == "Namespace"
+			continue
+		}
+		if len(fields) < 3 {
+			// The table ends with an empty line.
+			break
+		}
+		switch fields[0] {
+		case "^":
+		case "(cluster)":
+			namespace = ""
+		default:
+			namespace = fields[0]
+		}
+		refs = append(refs, &corev1.ResourceRef{
+			ApiVersion: wellKnownKindAPIVersions[fields[2]],
+			Kind:       fields[2],
+			Name:       fields[1],
+			Namespace:  namespace,
+		})
+	}
+	return refs
+}
+
+// setReconciliationOptions maps the core ReconciliationOptions onto the
+// equivalent fields of a PackageInstall spec.
+func setReconciliationOptions(pkgInstall map[string]interface{}, reconcile *corev1.ReconciliationOptions) error {
+	if reconcile.Interval > 0 {
+		syncPeriod := (time.Duration(reconcile.Interval) * time.Second).String()
+		if err := unstructured.SetNestedField(pkgInstall, syncPeriod, "spec", "syncPeriod"); err != nil {
+			return status.Errorf(codes.Internal, "unable to set the sync period: %v", err)
+		}
+	}
+	if err := unstructured.SetNestedField(pkgInstall, reconcile.Suspend, "spec", "paused"); err != nil {
+		return status.Errorf(codes.Internal, "unable to set the paused flag: %v", err)
+	}
+	if reconcile.ServiceAccountName != "" {
+		if err := unstructured.SetNestedField(pkgInstall, reconcile.ServiceAccountName, "spec", "serviceAccountName"); err != nil {
+			return status.Errorf(codes.Internal, "unable to set the service account: %v", err)
+		}
+	}
+	return nil
+}
+
+// valuesSecretName returns the name of the secret holding the user provided
+// values of a PackageInstall.
+func valuesSecretName(pkgInstallName string) string {
+	return fmt.Sprintf("%s-values", pkgInstallName)
+}
+
+// createOrUpdateValuesSecret stores the user provided values in a secret next
+// to the PackageInstall, which is how kapp-controller expects to receive them.
+// The secret is owned by the PackageInstall so that it does not outlive it.
+func (s *Server) createOrUpdateValuesSecret(ctx context.Context, client dynamic.Interface, pkgInstall *unstructured.Unstructured, values string) (string, error) {
+	namespace := pkgInstall.GetNamespace()
+	secretName := valuesSecretName(pkgInstall.GetName())
+	secretsResource := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+	secretsIfc := client.Resource(secretsResource).Namespace(namespace)
+
+	secret := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      secretName,
+				"namespace": namespace,
+				"ownerReferences": []interface{}{
+					map[string]interface{}{
+						"apiVersion": fmt.Sprintf("%s/%s", packagingGroup, packageVersion),
+						"kind":       packageResource,
+						"name":       pkgInstall.GetName(),
+						"uid":        string(pkgInstall.GetUID()),
+					},
+				},
+			},
+			"stringData": map[string]interface{}{
+				"values.yaml": values,
+			},
+		},
+	}
+
+	existing, err := secretsIfc.Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return "", status.Errorf(codes.Internal, "unable to get the values secret %q: %v", secretName, err)
+		}
+		if _, err = secretsIfc.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return "", status.Errorf(codes.Internal, "unable to create the values secret %q: %v", secretName, err)
+		}
+		return secretName, nil
+	}
+
+	secret.SetResourceVersion(existing.GetResourceVersion())
+	if _, err = secretsIfc.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return "", status.Errorf(codes.Internal, "unable to update the values secret %q: %v", secretName, err)
+	}
+	return secretName, nil
+}
+
+// deleteValuesSecret deletes the values secret of the named PackageInstall, if
+// any. A secret of the same name which is not owned by the PackageInstall was
+// not created by Kubeapps and is left alone.
+func deleteValuesSecret(ctx context.Context, client dynamic.Interface, namespace, pkgInstallName string) error {
+	secretName := valuesSecretName(pkgInstallName)
+	secretsResource := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+	secretsIfc := client.Resource(secretsResource).Namespace(namespace)
+
+	secret, err := secretsIfc.Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return status.Errorf(codes.Internal, "unable to get the values secret %q: %v", secretName, err)
+	}
+	owned := false
+	for _, owner := range secret.GetOwnerReferences() {
+		if owner.Kind == packageResource && owner.Name == pkgInstallName {
+			owned = true
+		}
+	}
+	if !owned {
+		return nil
+	}
+	if err = secretsIfc.Delete(ctx, secretName, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return status.Errorf(codes.Internal, "unable to delete the values secret %q: %v", secretName, err)
+	}
+	return nil
+}
+
+// WatchInstalledPackages streams the status of the installed packages as it
+// changes, by watching the PackageInstall CRs.
+func (s *Server) WatchInstalledPackages(request *corev1.WatchInstalledPackagesRequest, stream corev1.PackagesService_WatchInstalledPackagesServer) error {
+	ctx := stream.Context()
+	namespace := request.GetContext().GetNamespace()
+	cluster := request.GetContext().GetCluster()
+	listOptions := metav1.ListOptions{}
+	if installedPackageRef := request.GetInstalledPackageRef(); installedPackageRef != nil {
+		namespace = installedPackageRef.GetContext().GetNamespace()
+		cluster = installedPackageRef.GetContext().GetCluster()
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", installedPackageRef.GetIdentifier()).String()
+	}
+
+	log.Infof("+kapp_controller WatchInstalledPackages (cluster=[%s], namespace=[%s])", cluster, namespace)
+
+	if cluster != "" {
+		return status.Errorf(codes.Unimplemented, "Not supported yet: request.Context.Cluster: [%v]", cluster)
+	}
+
+	client, err := s.getDynamicClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	packageInstallResource := schema.GroupVersionResource{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}
+	watcher, err := client.Resource(packageInstallResource).Namespace(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to watch the PackageInstalls in the namespace %q: %v", namespace, err)
+	}
+	defer watcher.Stop()
+
+	sender := server.NewInstalledPackageStatusSender(stream)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "the watch of the PackageInstalls in the namespace %q was closed", namespace)
+			}
+			if event.Type == watch.Error {
+				return status.Errorf(codes.Internal, "unable to watch the PackageInstalls in the namespace %q: %v", namespace, k8serrors.FromObject(event.Object))
+			}
+			pkgInstall, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			ref := &corev1.InstalledPackageReference{
+				Context: &corev1.Context{
+					Namespace: pkgInstall.GetNamespace(),
+				},
+				Identifier: pkgInstall.GetName(),
+			}
+			if event.Type == watch.Deleted {
+				err = sender.SendDeleted(ref)
+			} else {
+				err = sender.Send(ref, installedPackageStatusFromUnstructured(pkgInstall))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// installedPackageStatusFromUnstructured returns the status of a PackageInstall
+// from the conditions set by kapp-controller.
+// See https://carvel.dev/kapp-controller/docs/latest/packaging/#packageinstall-cr
+func installedPackageStatusFromUnstructured(pkgInstall *unstructured.Unstructured) *corev1.InstalledPackageStatus {
+	installedPackageStatus := &corev1.InstalledPackageStatus{
+		Reason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+	}
+	if description, found, err := unstructured.NestedString(pkgInstall.Object, "status", "friendlyDescription"); found && err == nil {
+		installedPackageStatus.UserReason = description
+	}
+
+	// The conditions are stale until kapp-controller observes the latest
+	// generation of the PackageInstall.
+	observedGeneration, _, _ := unstructured.NestedInt64(pkgInstall.Object, "status", "observedGeneration")
+	if observedGeneration != pkgInstall.GetGeneration() {
+		return installedPackageStatus
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(pkgInstall.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["status"] != "True" {
+			continue
+		}
+		switch condition["type"] {
+		case "ReconcileSucceeded":
+			installedPackageStatus.Ready = true
+			installedPackageStatus.Reason = corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED
+		case "ReconcileFailed", "DeleteFailed":
+			installedPackageStatus.Reason = corev1.InstalledPackageStatus_STATUS_REASON_FAILED
+		}
+		if installedPackageStatus.UserReason == "" {
+			installedPackageStatus.UserReason = fmt.Sprintf("%v", condition["type"])
+		}
+	}
+	return installedPackageStatus
+}
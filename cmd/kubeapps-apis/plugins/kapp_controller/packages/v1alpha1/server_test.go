@@ -17,18 +17,27 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/plugins/kapp_controller/packages/v1alpha1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestGetClient(t *testing.T) {
@@ -193,9 +202,12 @@ func packagesFromSpecs(specs []map[string]interface{}, t *testing.T) []runtime.O
 
 func TestGetAvailablePackageSummaries(t *testing.T) {
 	testCases := []struct {
-		name             string
-		packageSpecs     []map[string]interface{}
-		expectedPackages []*corev1.AvailablePackageSummary
+		name                  string
+		packageSpecs          []map[string]interface{}
+		paginationOptions     *corev1.PaginationOptions
+		sortOptions           *corev1.SortOptions
+		expectedPackages      []*corev1.AvailablePackageSummary
+		expectedNextPageToken string
 	}{
 		{
 			name: "it returns carvel packages from the cluster",
@@ -228,6 +240,44 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "it returns the requested page of sorted carvel packages",
+			packageSpecs: []map[string]interface{}{
+				{
+					"spec": map[string]interface{}{
+						"packageRef": map[string]interface{}{
+							"refName": "tetris.foo.example.com",
+						},
+					},
+					"version": "1.2.3",
+				},
+				{
+					"spec": map[string]interface{}{
+						"packageRef": map[string]interface{}{
+							"refName": "another.foo.example.com",
+						},
+					},
+					"version": "1.2.5",
+				},
+				{
+					"spec": map[string]interface{}{
+						"packageRef": map[string]interface{}{
+							"refName": "pong.foo.example.com",
+						},
+					},
+					"version": "2.0.0",
+				},
+			},
+			paginationOptions: &corev1.PaginationOptions{PageSize: 1, PageToken: "1"},
+			sortOptions:       &corev1.SortOptions{Field: corev1.SortOptions_SORT_FIELD_LATEST_VERSION, Descending: true},
+			expectedPackages: []*corev1.AvailablePackageSummary{
+				{
+					DisplayName:   "another.foo.example.com",
+					LatestVersion: &corev1.PackageAppVersion{PkgVersion: "1.2.5"},
+				},
+			},
+			expectedNextPageToken: "2",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -245,7 +295,11 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 				},
 			}
 
-			response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{Context: &corev1.Context{}})
+			response, err := s.GetAvailablePackageSummaries(context.Background(), &corev1.GetAvailablePackageSummariesRequest{
+				Context:           &corev1.Context{},
+				PaginationOptions: tc.paginationOptions,
+				SortOptions:       tc.sortOptions,
+			})
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
@@ -254,6 +308,9 @@ func TestGetAvailablePackageSummaries(t *testing.T) {
 			if got, want := response.AvailablePackageSummaries, tc.expectedPackages; !cmp.Equal(got, want, opt1) {
 				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opt1))
 			}
+			if got, want := response.NextPageToken, tc.expectedNextPageToken; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
 		})
 	}
 
@@ -473,3 +530,619 @@ func TestPackageRepositoryFromUnstructured(t *testing.T) {
 		})
 	}
 }
+
+func packageInstallFromSpec(name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": fmt.Sprintf("%s/%s", packagingGroup, packageVersion),
+			"kind":       packageResource,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestUpdateInstalledPackage(t *testing.T) {
+	testCases := []struct {
+		name               string
+		request            *corev1.UpdateInstalledPackageRequest
+		existingObjects    []runtime.Object
+		expectedStatusCode codes.Code
+		expectedResponse   *corev1.UpdateInstalledPackageResponse
+		expectedSpec       map[string]interface{}
+	}{
+		{
+			name: "updates the version, values and reconciliation options",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+				PkgVersionReference: &corev1.VersionReference{
+					Version: "1.2.4",
+				},
+				Values: "foo: bar",
+				ReconciliationOptions: &corev1.ReconciliationOptions{
+					Interval:           60,
+					ServiceAccountName: "default-sa",
+				},
+			},
+			existingObjects: []runtime.Object{
+				packageInstallFromSpec("my-install", "default", map[string]interface{}{
+					"packageRef": map[string]interface{}{
+						"refName": "tetris.foo.example.com",
+						"versionSelection": map[string]interface{}{
+							"constraints": "1.2.3",
+						},
+					},
+				}),
+			},
+			expectedStatusCode: codes.OK,
+			expectedResponse: &corev1.UpdateInstalledPackageResponse{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+					Plugin:     GetPluginDetail(),
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"packageRef": map[string]interface{}{
+					"refName": "tetris.foo.example.com",
+					"versionSelection": map[string]interface{}{
+						"constraints": "1.2.4",
+					},
+				},
+				"paused":             false,
+				"serviceAccountName": "default-sa",
+				"syncPeriod":         "1m0s",
+				"values": []interface{}{
+					map[string]interface{}{
+						"secretRef": map[string]interface{}{
+							"name": "my-install-values",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "returns not found if the package install does not exist",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument if the identifier is missing",
+			request: &corev1.UpdateInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}: "PackageInstallList",
+				},
+				tc.existingObjects...,
+			)
+			s := Server{
+				clientGetter: func(context.Context) (dynamic.Interface, error) {
+					return dynamicClient, nil
+				},
+			}
+
+			response, err := s.UpdateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			// We don't need to check anything else for non-OK codes.
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(
+				corev1.UpdateInstalledPackageResponse{},
+				corev1.InstalledPackageReference{},
+				corev1.Context{},
+				plugins.Plugin{})
+			if got, want := response, tc.expectedResponse; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+
+			packageInstallResource := schema.GroupVersionResource{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}
+			updated, err := dynamicClient.Resource(packageInstallResource).Namespace("default").Get(context.Background(), "my-install", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := updated.Object["spec"], tc.expectedSpec; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+
+			secretsResource := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+			secret, err := dynamicClient.Resource(secretsResource).Namespace("default").Get(context.Background(), "my-install-values", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got, want := secret.Object["stringData"], map[string]interface{}{"values.yaml": tc.request.Values}; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			expectedOwners := []metav1.OwnerReference{
+				{
+					APIVersion: fmt.Sprintf("%s/%s", packagingGroup, packageVersion),
+					Kind:       packageResource,
+					Name:       "my-install",
+				},
+			}
+			if got, want := secret.GetOwnerReferences(), expectedOwners; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func valuesSecret(name, namespace string, owners ...metav1.OwnerReference) *unstructured.Unstructured {
+	secret := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"stringData": map[string]interface{}{
+				"values.yaml": "foo: bar",
+			},
+		},
+	}
+	secret.SetOwnerReferences(owners)
+	return secret
+}
+
+func TestDeleteInstalledPackage(t *testing.T) {
+	owner := metav1.OwnerReference{
+		APIVersion: fmt.Sprintf("%s/%s", packagingGroup, packageVersion),
+		Kind:       packageResource,
+		Name:       "my-install",
+	}
+	testCases := []struct {
+		name                 string
+		request              *corev1.DeleteInstalledPackageRequest
+		existingSecret       *unstructured.Unstructured
+		expectedStatusCode   codes.Code
+		expectSecretToRemain bool
+	}{
+		{
+			name: "deletes the package install",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+			},
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "deletes the values secret of the package install",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+			},
+			existingSecret:     valuesSecret("my-install-values", "default", owner),
+			expectedStatusCode: codes.OK,
+		},
+		{
+			name: "leaves a values secret not owned by the package install",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+			},
+			existingSecret:       valuesSecret("my-install-values", "default"),
+			expectedStatusCode:   codes.OK,
+			expectSecretToRemain: true,
+		},
+		{
+			name: "returns not found if the package install does not exist",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument if the context is missing",
+			request: &corev1.DeleteInstalledPackageRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-install",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			packageInstallResource := schema.GroupVersionResource{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}
+			existingObjects := []runtime.Object{packageInstallFromSpec("my-install", "default", map[string]interface{}{})}
+			if tc.existingSecret != nil {
+				existingObjects = append(existingObjects, tc.existingSecret)
+			}
+			dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+				runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					packageInstallResource: "PackageInstallList",
+				},
+				existingObjects...,
+			)
+			s := Server{
+				clientGetter: func(context.Context) (dynamic.Interface, error) {
+					return dynamicClient, nil
+				},
+			}
+
+			_, err := s.DeleteInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			_, err = dynamicClient.Resource(packageInstallResource).Namespace("default").Get(context.Background(), "my-install", metav1.GetOptions{})
+			if !k8serrors.IsNotFound(err) {
+				t.Errorf("got: %+v, want: not found error", err)
+			}
+
+			if tc.existingSecret == nil {
+				return
+			}
+			secretsResource := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+			_, err = dynamicClient.Resource(secretsResource).Namespace("default").Get(context.Background(), "my-install-values", metav1.GetOptions{})
+			if tc.expectSecretToRemain {
+				if err != nil {
+					t.Errorf("got: %+v, want: the values secret to remain", err)
+				}
+			} else if !k8serrors.IsNotFound(err) {
+				t.Errorf("got: %+v, want: not found error", err)
+			}
+		})
+	}
+}
+
+func TestResourceRefsFromInspectOutput(t *testing.T) {
+	output := `Target cluster 'https://10.96.0.1:443' (nodes: kind-control-plane)
+
+Resources in app 'my-install-ctrl'
+
+Namespace  Name                      Kind           Owner    Conds.  Rs  Ri  Age
+(cluster)  my-role                   ClusterRole    kapp     -       ok  -   1m
+default    my-install                Deployment     kapp     2/2 t   ok  -   1m
+^          my-install                Service        kapp     -       ok  -   1m
+^          my-install-certificate    Certificate    kapp     1/1 t   ok  -   1m
+
+Rs: Reconcile state
+Ri: Reconcile information
+
+4 resources
+
+Succeeded`
+
+	expected := []*corev1.ResourceRef{
+		{ApiVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "my-role"},
+		{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-install", Namespace: "default"},
+		{ApiVersion: "v1", Kind: "Service", Name: "my-install", Namespace: "default"},
+		{Kind: "Certificate", Name: "my-install-certificate", Namespace: "default"},
+	}
+
+	opts := cmpopts.IgnoreUnexported(corev1.ResourceRef{})
+	if got, want := resourceRefsFromInspectOutput(output), expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
+
+func TestGetInstalledPackageResourceStatuses(t *testing.T) {
+	app := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": fmt.Sprintf("%s/%s", appGroup, appVersion),
+			"kind":       "App",
+			"metadata": map[string]interface{}{
+				"name":      "my-install",
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"inspect": map[string]interface{}{
+					"stdout": `Namespace  Name        Kind        Owner  Conds.  Rs  Ri  Age
+default    my-install  Deployment  kapp   2/2 t   ok  -   1m
+^          my-install  Service     kapp   -       ok  -   1m
+`,
+				},
+			},
+		},
+	}
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":      "my-install",
+				"namespace": "default",
+			},
+		},
+	}
+	deploymentRef := &corev1.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-install", Namespace: "default"}
+	serviceRef := &corev1.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-install", Namespace: "default"}
+	discoveryClient := &fakediscovery.FakeDiscovery{
+		Fake: &k8stesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{{Name: "services", Kind: "Service", Namespaced: true}},
+				},
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		request            *corev1.GetInstalledPackageResourceStatusesRequest
+		expectedStatusCode codes.Code
+		expectedStatuses   []*corev1.ResourceStatus
+	}{
+		{
+			name: "returns the readiness of the app resources",
+			request: &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "my-install",
+				},
+			},
+			expectedStatusCode: codes.OK,
+			expectedStatuses: []*corev1.ResourceStatus{
+				{ResourceRef: deploymentRef, Reason: "Not found"},
+				{ResourceRef: serviceRef, Ready: true},
+			},
+		},
+		{
+			name: "returns not found if the app does not exist",
+			request: &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Context: &corev1.Context{
+						Namespace: "default",
+					},
+					Identifier: "not-a-valid-identifier",
+				},
+			},
+			expectedStatusCode: codes.NotFound,
+		},
+		{
+			name: "returns invalid argument if the context is missing",
+			request: &corev1.GetInstalledPackageResourceStatusesRequest{
+				InstalledPackageRef: &corev1.InstalledPackageReference{
+					Identifier: "my-install",
+				},
+			},
+			expectedStatusCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicClient := dynfake.NewSimpleDynamicClient(runtime.NewScheme(), app, service)
+			s := Server{
+				clientGetter: func(context.Context) (dynamic.Interface, error) {
+					return dynamicClient, nil
+				},
+				discoveryClientGetter: func(context.Context) (discovery.DiscoveryInterface, error) {
+					return discoveryClient, nil
+				},
+			}
+
+			response, err := s.GetInstalledPackageResourceStatuses(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.expectedStatusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.expectedStatusCode != codes.OK {
+				return
+			}
+
+			opts := cmpopts.IgnoreUnexported(corev1.ResourceRef{}, corev1.ResourceStatus{})
+			if got, want := response.ResourceStatuses, tc.expectedStatuses; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+func TestInstalledPackageStatusFromUnstructured(t *testing.T) {
+	testCases := []struct {
+		name           string
+		status         map[string]interface{}
+		expectedStatus *corev1.InstalledPackageStatus
+	}{
+		{
+			name: "returns installed when the reconcile succeeded",
+			status: map[string]interface{}{
+				"observedGeneration":  int64(1),
+				"friendlyDescription": "Reconcile succeeded",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "ReconcileSucceeded", "status": "True"},
+				},
+			},
+			expectedStatus: &corev1.InstalledPackageStatus{
+				Ready:      true,
+				Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+				UserReason: "Reconcile succeeded",
+			},
+		},
+		{
+			name: "returns failed when the reconcile failed",
+			status: map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "ReconcileFailed", "status": "True"},
+				},
+			},
+			expectedStatus: &corev1.InstalledPackageStatus{
+				Reason:     corev1.InstalledPackageStatus_STATUS_REASON_FAILED,
+				UserReason: "ReconcileFailed",
+			},
+		},
+		{
+			name: "returns pending when the latest generation is not yet observed",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "ReconcileSucceeded", "status": "True"},
+				},
+			},
+			expectedStatus: &corev1.InstalledPackageStatus{
+				Reason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pkgInstall := packageInstallFromSpec("my-tetris", "default", map[string]interface{}{})
+			pkgInstall.SetGeneration(1)
+			pkgInstall.Object["status"] = tc.status
+
+			opts := cmpopts.IgnoreUnexported(corev1.InstalledPackageStatus{})
+			if got, want := installedPackageStatusFromUnstructured(pkgInstall), tc.expectedStatus; !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		})
+	}
+}
+
+// fakeWatchStream collects the responses sent on a WatchInstalledPackages stream.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *corev1.WatchInstalledPackagesResponse
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(response *corev1.WatchInstalledPackagesResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestWatchInstalledPackages(t *testing.T) {
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: packagingGroup, Version: packageVersion, Resource: packagesResource}: "PackageInstallList",
+		},
+	)
+	watcher := watch.NewFake()
+	dynamicClient.PrependWatchReactor(packagesResource, k8stesting.DefaultWatchReactor(watcher, nil))
+	s := Server{
+		clientGetter: func(context.Context) (dynamic.Interface, error) {
+			return dynamicClient, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, responses: make(chan *corev1.WatchInstalledPackagesResponse, 10)}
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- s.WatchInstalledPackages(&corev1.WatchInstalledPackagesRequest{
+			Context: &corev1.Context{Namespace: "default"},
+		}, stream)
+	}()
+
+	pkgInstall := packageInstallFromSpec("my-tetris", "default", map[string]interface{}{})
+	watcher.Add(pkgInstall.DeepCopy())
+	watcher.Modify(pkgInstall.DeepCopy())
+	pkgInstall.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "ReconcileSucceeded", "status": "True"},
+		},
+	}
+	watcher.Modify(pkgInstall.DeepCopy())
+	watcher.Delete(pkgInstall.DeepCopy())
+
+	ref := &corev1.InstalledPackageReference{
+		Context:    &corev1.Context{Namespace: "default"},
+		Identifier: "my-tetris",
+	}
+	installedStatus := &corev1.InstalledPackageStatus{
+		Ready:      true,
+		Reason:     corev1.InstalledPackageStatus_STATUS_REASON_INSTALLED,
+		UserReason: "ReconcileSucceeded",
+	}
+	expectedResponses := []*corev1.WatchInstalledPackagesResponse{
+		{InstalledPackageRef: ref, Status: &corev1.InstalledPackageStatus{Reason: corev1.InstalledPackageStatus_STATUS_REASON_PENDING}},
+		{InstalledPackageRef: ref, Status: installedStatus},
+		{InstalledPackageRef: ref, Status: installedStatus, Deleted: true},
+	}
+	opts := cmpopts.IgnoreUnexported(
+		corev1.WatchInstalledPackagesResponse{},
+		corev1.InstalledPackageReference{},
+		corev1.Context{},
+		corev1.InstalledPackageStatus{},
+	)
+	for _, want := range expectedResponses {
+		select {
+		case got := <-stream.responses:
+			if !cmp.Equal(want, got, opts) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %+v", want)
+		}
+	}
+
+	cancel()
+	if err := <-watchErr; err != nil {
+		t.Errorf("%+v", err)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statuserror converts the errors returned by the Kubernetes API into
+// gRPC status errors for the plugins.
+package statuserror
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// FromK8sError returns a gRPC status error for an error returned by the
+// Kubernetes API when performing the verb on the identified resource, with the
+// code matching the reason of the error. Errors which already carry a gRPC
+// status are returned unchanged.
+func FromK8sError(verb, resource, identifier string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if identifier == "" {
+		identifier = "all"
+	}
+	var code codes.Code
+	switch {
+	case k8serrors.IsNotFound(err):
+		code = codes.NotFound
+	case k8serrors.IsForbidden(err):
+		code = codes.PermissionDenied
+	case k8serrors.IsUnauthorized(err):
+		code = codes.Unauthenticated
+	case k8serrors.IsAlreadyExists(err):
+		code = codes.AlreadyExists
+	case k8serrors.IsConflict(err):
+		code = codes.Aborted
+	case k8serrors.IsBadRequest(err), k8serrors.IsInvalid(err):
+		code = codes.InvalidArgument
+	case k8serrors.IsTimeout(err), k8serrors.IsServerTimeout(err):
+		code = codes.DeadlineExceeded
+	case k8serrors.IsTooManyRequests(err), k8serrors.IsServiceUnavailable(err):
+		code = codes.Unavailable
+	default:
+		code = codes.Internal
+	}
+	return status.Errorf(code, "Unable to %s the %s %q due to %v", verb, resource, identifier, err)
+}
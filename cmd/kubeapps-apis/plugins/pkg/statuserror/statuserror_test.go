@@ -0,0 +1,76 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package statuserror
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestFromK8sError(t *testing.T) {
+	resource := schema.GroupResource{Group: "helm.toolkit.fluxcd.io", Resource: "helmreleases"}
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "returns not found for a missing resource",
+			err:          k8serrors.NewNotFound(resource, "my-release"),
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "returns permission denied for a forbidden request",
+			err:          k8serrors.NewForbidden(resource, "my-release", fmt.Errorf("no access")),
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "returns unauthenticated for an unauthorized request",
+			err:          k8serrors.NewUnauthorized("bad token"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "returns already exists for a conflicting create",
+			err:          k8serrors.NewAlreadyExists(resource, "my-release"),
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "returns internal for other errors",
+			err:          fmt.Errorf("connection refused"),
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "returns status errors unchanged",
+			err:          status.Errorf(codes.InvalidArgument, "unknown cluster"),
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := FromK8sError("get", "HelmRelease", "my-release", tc.err)
+			if got, want := status.Code(err), tc.expectedCode; got != want {
+				t.Errorf("got: %v, want: %v", got, want)
+			}
+		})
+	}
+
+	if err := FromK8sError("get", "HelmRelease", "my-release", nil); err != nil {
+		t.Errorf("got: %v, want: nil", err)
+	}
+}
@@ -14,12 +14,19 @@ package server
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	"github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	log "k8s.io/klog/v2"
 )
 
@@ -30,11 +37,16 @@ type packagesServer struct {
 	// plugins is a slice of all registered plugins which satisfy the core.packages.v1alpha1
 	// interface.
 	plugins []*pkgsPluginWithServer
+
+	// pluginTimeout is the maximum time to wait for each plugin when a request
+	// is aggregated across all the plugins. Zero means no timeout.
+	pluginTimeout time.Duration
 }
 
-func NewPackagesServer(plugins []*pkgsPluginWithServer) *packagesServer {
+func NewPackagesServer(plugins []*pkgsPluginWithServer, pluginTimeout time.Duration) *packagesServer {
 	return &packagesServer{
-		plugins: plugins,
+		plugins:       plugins,
+		pluginTimeout: pluginTimeout,
 	}
 }
 
@@ -47,13 +59,46 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 
 	log.Infof("+core GetAvailablePackageSummaries %s", contextMsg)
 
+	pageSize := request.GetPaginationOptions().GetPageSize()
+	pageToken, err := decodeCompositePageToken(request.GetPaginationOptions().GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
+	// Each plugin is asked for a full page of results starting at its own
+	// offset, so that the first page size results of the merged and sorted
+	// results are the next page of the aggregated catalog.
+	results := s.callAllPlugins(ctx, func(ctx context.Context, p *pkgsPluginWithServer) (interface{}, error) {
+		offset, ok := pageToken.offset(p.plugin)
+		if !ok {
+			// The plugin has no more results.
+			return nil, nil
+		}
+		pluginRequest := proto.Clone(request).(*packages.GetAvailablePackageSummariesRequest)
+		pluginRequest.PaginationOptions = &packages.PaginationOptions{PageSize: pageSize}
+		if offset > 0 {
+			pluginRequest.PaginationOptions.PageToken = fmt.Sprintf("%d", offset)
+		}
+		return p.server.GetAvailablePackageSummaries(ctx, pluginRequest)
+	})
+
 	pkgs := []*packages.AvailablePackageSummary{}
-	// TODO: We can do these in parallel in separate go routines.
-	for _, p := range s.plugins {
-		response, err := p.server.GetAvailablePackageSummaries(ctx, request)
-		if err != nil {
-			return nil, err
+	categories := []string{}
+	pluginErrors := []*packages.PluginError{}
+	pluginPages := []pluginPage{}
+	pluginsQueried := 0
+	for _, result := range results {
+		if result.err != nil {
+			log.Errorf("Unable to get the available package summaries from the plugin %v: %v", result.plugin.Name, result.err)
+			pluginErrors = append(pluginErrors, pluginErrorFromResult(result))
+			pluginsQueried++
+			continue
 		}
+		response, ok := result.response.(*packages.GetAvailablePackageSummariesResponse)
+		if !ok || response == nil {
+			continue
+		}
+		pluginsQueried++
 
 		// Add the plugin for the pkgs
 		pluginPkgs := response.AvailablePackageSummaries
@@ -61,14 +106,40 @@ func (s packagesServer) GetAvailablePackageSummaries(ctx context.Context, reques
 			if r.AvailablePackageRef == nil {
 				r.AvailablePackageRef = &packages.AvailablePackageReference{}
 			}
-			r.AvailablePackageRef.Plugin = p.plugin
+			r.AvailablePackageRef.Plugin = result.plugin
 		}
 		pkgs = append(pkgs, pluginPkgs...)
+		categories = append(categories, response.Categories...)
+		pluginPages = append(pluginPages, pluginPage{result.plugin, len(pluginPkgs), response.NextPageToken})
+	}
+
+	// Only fail the request when there are no results at all to return.
+	if pluginsQueried > 0 && len(pluginErrors) == pluginsQueried {
+		return nil, status.Errorf(codes.Code(pluginErrors[0].StatusCode), "Unable to get the available package summaries from any plugin: %s", pluginErrorsMessage(pluginErrors))
+	}
+
+	SortAvailablePackageSummaries(pkgs, request.GetSortOptions())
+	if pageSize > 0 && len(pkgs) > int(pageSize) {
+		pkgs = pkgs[:pageSize]
+	}
+
+	nextPageToken := ""
+	if pageSize > 0 {
+		consumed := map[string]int{}
+		for _, pkg := range pkgs {
+			consumed[pkg.GetAvailablePackageRef().GetPlugin().GetName()]++
+		}
+		nextPageToken, err = nextCompositePageToken(pageToken, pluginPages, consumed)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create the next page token: %v", err)
+		}
 	}
 
-	// TODO: Sort via default sort order or that specified in request.
 	return &packages.GetAvailablePackageSummariesResponse{
 		AvailablePackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
+		Categories:                distinctSortedStrings(categories),
+		PluginErrors:              pluginErrors,
 	}, nil
 }
 
@@ -98,7 +169,7 @@ func (s packagesServer) GetAvailablePackageDetail(ctx context.Context, request *
 	// Retrieve the plugin with server matching the requested plugin name
 	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
 	if pluginWithServer == nil {
-		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", pluginWithServer.plugin.Name)
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
 	}
 
 	// Get the response from the requested plugin
@@ -118,6 +189,763 @@ func (s packagesServer) GetAvailablePackageDetail(ctx context.Context, request *
 	}, nil
 }
 
+// GetAvailablePackageVersions returns the package versions based on the request.
+func (s packagesServer) GetAvailablePackageVersions(ctx context.Context, request *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error) {
+	contextMsg := ""
+	if request.AvailablePackageRef != nil && request.AvailablePackageRef.Context != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.AvailablePackageRef.Context.Cluster, request.AvailablePackageRef.Context.Namespace)
+	}
+
+	log.Infof("+core GetAvailablePackageVersions %s", contextMsg)
+
+	// Check prerequsites
+	if request.AvailablePackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the available package reference (missing AvailablePackageRef)")
+	}
+	if request.AvailablePackageRef.Context == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the context (missing AvailablePackageRef.Context)")
+	}
+	if request.AvailablePackageRef.Identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the identifier (missing AvailablePackageRef.Identifier)")
+	}
+	if request.AvailablePackageRef.Plugin == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetAvailablePackageVersions(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetAvailablePackageVersions from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.PackageAppVersions == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid GetAvailablePackageVersions response from the plugin %v", pluginWithServer.plugin.Name)
+	}
+
+	// Build the response
+	return &packages.GetAvailablePackageVersionsResponse{
+		PackageAppVersions: response.PackageAppVersions,
+	}, nil
+}
+
+// GetInstalledPackageSummaries returns the installed packages of all the
+// plugins, merged and sorted by name.
+func (s packagesServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	contextMsg := ""
+	if request.Context != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.Context.Cluster, request.Context.Namespace)
+	}
+
+	log.Infof("+core GetInstalledPackageSummaries %s", contextMsg)
+
+	pageSize := request.GetPaginationOptions().GetPageSize()
+	pageToken, err := decodeCompositePageToken(request.GetPaginationOptions().GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to intepret page token %q: %v", request.GetPaginationOptions().GetPageToken(), err)
+	}
+
+	// As for the available packages, each plugin is asked for a full page of
+	// results from its own offset. The plugins return their installed packages
+	// sorted by name, so the merged results are a page of the aggregated list.
+	results := s.callAllPlugins(ctx, func(ctx context.Context, p *pkgsPluginWithServer) (interface{}, error) {
+		offset, ok := pageToken.offset(p.plugin)
+		if !ok {
+			// The plugin has no more results.
+			return nil, nil
+		}
+		pluginRequest := proto.Clone(request).(*packages.GetInstalledPackageSummariesRequest)
+		pluginRequest.PaginationOptions = &packages.PaginationOptions{PageSize: pageSize}
+		if offset > 0 {
+			pluginRequest.PaginationOptions.PageToken = fmt.Sprintf("%d", offset)
+		}
+		return p.server.GetInstalledPackageSummaries(ctx, pluginRequest)
+	})
+
+	pkgs := []*packages.InstalledPackageSummary{}
+	pluginErrors := []*packages.PluginError{}
+	pluginPages := []pluginPage{}
+	pluginsQueried := 0
+	for _, result := range results {
+		if result.err != nil {
+			if status.Code(result.err) == codes.Unimplemented {
+				// Not every plugin manages installed packages.
+				continue
+			}
+			log.Errorf("Unable to get the installed package summaries from the plugin %v: %v", result.plugin.Name, result.err)
+			pluginErrors = append(pluginErrors, pluginErrorFromResult(result))
+			pluginsQueried++
+			continue
+		}
+		response, ok := result.response.(*packages.GetInstalledPackageSummariesResponse)
+		if !ok || response == nil {
+			continue
+		}
+		pluginsQueried++
+
+		// Add the plugin for the pkgs
+		pluginPkgs := response.InstalledPackageSummaries
+		for _, r := range pluginPkgs {
+			if r.InstalledPackageRef == nil {
+				r.InstalledPackageRef = &packages.InstalledPackageReference{}
+			}
+			r.InstalledPackageRef.Plugin = result.plugin
+		}
+		pkgs = append(pkgs, pluginPkgs...)
+		pluginPages = append(pluginPages, pluginPage{result.plugin, len(pluginPkgs), response.NextPageToken})
+	}
+
+	// Only fail the request when there are no results at all to return.
+	if pluginsQueried > 0 && len(pluginErrors) == pluginsQueried {
+		return nil, status.Errorf(codes.Code(pluginErrors[0].StatusCode), "Unable to get the installed package summaries from any plugin: %s", pluginErrorsMessage(pluginErrors))
+	}
+
+	SortInstalledPackageSummaries(pkgs)
+	if pageSize > 0 && len(pkgs) > int(pageSize) {
+		pkgs = pkgs[:pageSize]
+	}
+
+	nextPageToken := ""
+	if pageSize > 0 {
+		consumed := map[string]int{}
+		for _, pkg := range pkgs {
+			consumed[pkg.GetInstalledPackageRef().GetPlugin().GetName()]++
+		}
+		nextPageToken, err = nextCompositePageToken(pageToken, pluginPages, consumed)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Unable to create the next page token: %v", err)
+		}
+	}
+
+	return &packages.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
+		PluginErrors:              pluginErrors,
+	}, nil
+}
+
+// GetInstalledPackageDetail returns the installed package details based on the request.
+func (s packagesServer) GetInstalledPackageDetail(ctx context.Context, request *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error) {
+	contextMsg := ""
+	if request.InstalledPackageRef != nil && request.InstalledPackageRef.Context != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.InstalledPackageRef.Context.Cluster, request.InstalledPackageRef.Context.Namespace)
+	}
+
+	log.Infof("+core GetInstalledPackageDetail %s", contextMsg)
+
+	// Check prerequsites
+	if request.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the installed package reference (missing InstalledPackageRef)")
+	}
+	if request.InstalledPackageRef.Context == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the context (missing InstalledPackageRef.Context)")
+	}
+	if request.InstalledPackageRef.Identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the identifier (missing InstalledPackageRef.Identifier)")
+	}
+	if request.InstalledPackageRef.Plugin == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageDetail(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetInstalledPackageDetail from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageDetail == nil || response.InstalledPackageDetail.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid GetInstalledPackageDetail response from the plugin %v", pluginWithServer.plugin.Name)
+	}
+
+	// Ensure the plugin is set on the references, so that subsequent requests
+	// are routed to the same plugin.
+	response.InstalledPackageDetail.InstalledPackageRef.Plugin = pluginWithServer.plugin
+	if response.InstalledPackageDetail.AvailablePackageRef != nil {
+		response.InstalledPackageDetail.AvailablePackageRef.Plugin = pluginWithServer.plugin
+	}
+
+	// Build the response
+	return &packages.GetInstalledPackageDetailResponse{
+		InstalledPackageDetail: response.InstalledPackageDetail,
+	}, nil
+}
+
+// CreateInstalledPackage creates the installed package based on the request.
+func (s packagesServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	contextMsg := ""
+	if request.TargetContext != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.TargetContext.Cluster, request.TargetContext.Namespace)
+	}
+
+	log.Infof("+core CreateInstalledPackage %s", contextMsg)
+
+	// Check prerequsites
+	if request.AvailablePackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the available package reference (missing AvailablePackageRef)")
+	}
+	if request.AvailablePackageRef.Plugin == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+	if request.TargetContext == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the target context (missing TargetContext)")
+	}
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the name (missing Name)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.CreateInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to create the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid CreateInstalledPackage response from the plugin %v", pluginWithServer.plugin.Name)
+	}
+	response.InstalledPackageRef.Plugin = pluginWithServer.plugin
+
+	// Build the response
+	return &packages.CreateInstalledPackageResponse{
+		InstalledPackageRef: response.InstalledPackageRef,
+	}, nil
+}
+
+// RenderInstalledPackage renders the manifest of a package as it would be
+// installed with the request, without installing it.
+func (s packagesServer) RenderInstalledPackage(ctx context.Context, request *packages.RenderInstalledPackageRequest) (*packages.RenderInstalledPackageResponse, error) {
+	contextMsg := ""
+	if request.TargetContext != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.TargetContext.Cluster, request.TargetContext.Namespace)
+	}
+
+	log.Infof("+core RenderInstalledPackage %s", contextMsg)
+
+	// Check prerequsites
+	if request.AvailablePackageRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the available package reference (missing AvailablePackageRef)")
+	}
+	if request.AvailablePackageRef.Plugin == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing AvailablePackageRef.Plugin)")
+	}
+	if request.TargetContext == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the target context (missing TargetContext)")
+	}
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the name (missing Name)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(request.AvailablePackageRef.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", request.AvailablePackageRef.Plugin)
+	}
+
+	renderServer, ok := pluginWithServer.server.(PackageRenderPluginServer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "The plugin %v does not support rendering installed packages", pluginWithServer.plugin.Name)
+	}
+
+	// Get the response from the requested plugin
+	response, err := renderServer.RenderInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to render the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.RenderInstalledPackageResponse{
+		Manifest:     response.Manifest,
+		ResourceRefs: response.ResourceRefs,
+	}, nil
+}
+
+// DiffInstalledPackage returns the differences between the resources of the
+// installed package and those of the proposed update.
+func (s packagesServer) DiffInstalledPackage(ctx context.Context, request *packages.DiffInstalledPackageRequest) (*packages.DiffInstalledPackageResponse, error) {
+	log.Infof("+core DiffInstalledPackage %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	diffServer, ok := pluginWithServer.server.(PackageDiffPluginServer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "The plugin %v does not support diffing installed packages", pluginWithServer.plugin.Name)
+	}
+
+	// Get the response from the requested plugin
+	response, err := diffServer.DiffInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to diff the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.DiffInstalledPackageResponse{
+		ResourceDiffs: response.ResourceDiffs,
+	}, nil
+}
+
+// UpdateInstalledPackage updates the installed package based on the request.
+func (s packagesServer) UpdateInstalledPackage(ctx context.Context, request *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error) {
+	log.Infof("+core UpdateInstalledPackage %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.UpdateInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to update the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid UpdateInstalledPackage response from the plugin %v", pluginWithServer.plugin.Name)
+	}
+
+	// Build the response
+	return &packages.UpdateInstalledPackageResponse{
+		InstalledPackageRef: response.InstalledPackageRef,
+	}, nil
+}
+
+// DeleteInstalledPackage deletes the installed package based on the request.
+func (s packagesServer) DeleteInstalledPackage(ctx context.Context, request *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error) {
+	log.Infof("+core DeleteInstalledPackage %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
+	_, err = pluginWithServer.server.DeleteInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to delete the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.DeleteInstalledPackageResponse{}, nil
+}
+
+// GetInstalledPackageResourceRefs returns the references for the Kubernetes
+// resources created by an installed package.
+func (s packagesServer) GetInstalledPackageResourceRefs(ctx context.Context, request *packages.GetInstalledPackageResourceRefsRequest) (*packages.GetInstalledPackageResourceRefsResponse, error) {
+	log.Infof("+core GetInstalledPackageResourceRefs %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageResourceRefs(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetInstalledPackageResourceRefs from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.GetInstalledPackageResourceRefsResponse{
+		ResourceRefs: response.ResourceRefs,
+	}, nil
+}
+
+// GetInstalledPackageResourceStatuses returns the readiness of the Kubernetes
+// resources created by an installed package.
+func (s packagesServer) GetInstalledPackageResourceStatuses(ctx context.Context, request *packages.GetInstalledPackageResourceStatusesRequest) (*packages.GetInstalledPackageResourceStatusesResponse, error) {
+	log.Infof("+core GetInstalledPackageResourceStatuses %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the response from the requested plugin
+	response, err := pluginWithServer.server.GetInstalledPackageResourceStatuses(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetInstalledPackageResourceStatuses from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.GetInstalledPackageResourceStatusesResponse{
+		ResourceStatuses: response.ResourceStatuses,
+	}, nil
+}
+
+// GetInstalledPackageRevisions returns the revision history of an installed
+// package.
+func (s packagesServer) GetInstalledPackageRevisions(ctx context.Context, request *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error) {
+	log.Infof("+core GetInstalledPackageRevisions %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionsServer, ok := pluginWithServer.server.(PackageRevisionsPluginServer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "The plugin %v does not support the revisions of installed packages", pluginWithServer.plugin.Name)
+	}
+
+	// Get the response from the requested plugin
+	response, err := revisionsServer.GetInstalledPackageRevisions(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable get the GetInstalledPackageRevisions from the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Build the response
+	return &packages.GetInstalledPackageRevisionsResponse{
+		Revisions: response.Revisions,
+	}, nil
+}
+
+// RollbackInstalledPackage rolls back an installed package to a previous
+// revision.
+func (s packagesServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	log.Infof("+core RollbackInstalledPackage %s", installedPackageRefContextMsg(request.InstalledPackageRef))
+
+	pluginWithServer, err := s.getInstalledPackagePluginWithServer(request.InstalledPackageRef)
+	if err != nil {
+		return nil, err
+	}
+	if request.Revision <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the revision (missing or invalid Revision)")
+	}
+
+	revisionsServer, ok := pluginWithServer.server.(PackageRevisionsPluginServer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "The plugin %v does not support the revisions of installed packages", pluginWithServer.plugin.Name)
+	}
+
+	// Get the response from the requested plugin
+	response, err := revisionsServer.RollbackInstalledPackage(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(status.Convert(err).Code(), "Unable to roll back the installed package using the plugin %v: %v", pluginWithServer.plugin.Name, err)
+	}
+
+	// Validate the plugin response
+	if response.InstalledPackageRef == nil {
+		return nil, status.Errorf(codes.Internal, "Invalid RollbackInstalledPackage response from the plugin %v", pluginWithServer.plugin.Name)
+	}
+
+	// Ensure the plugin is set on the reference, so that subsequent requests
+	// are routed to the same plugin.
+	response.InstalledPackageRef.Plugin = pluginWithServer.plugin
+
+	// Build the response
+	return &packages.RollbackInstalledPackageResponse{
+		InstalledPackageRef: response.InstalledPackageRef,
+	}, nil
+}
+
+// installedPackageRefContextMsg returns the context of an installed package
+// reference for logging.
+func installedPackageRefContextMsg(ref *packages.InstalledPackageReference) string {
+	if ref == nil || ref.Context == nil {
+		return ""
+	}
+	return fmt.Sprintf("(cluster=[%s], namespace=[%s])", ref.Context.Cluster, ref.Context.Namespace)
+}
+
+// getInstalledPackagePluginWithServer checks that the installed package
+// reference is complete and returns the plugin with server handling it.
+func (s packagesServer) getInstalledPackagePluginWithServer(ref *packages.InstalledPackageReference) (*pkgsPluginWithServer, error) {
+	// Check prerequsites
+	if ref == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the installed package reference (missing InstalledPackageRef)")
+	}
+	if ref.Context == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the context (missing InstalledPackageRef.Context)")
+	}
+	if ref.Identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the identifier (missing InstalledPackageRef.Identifier)")
+	}
+	if ref.Plugin == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+	}
+
+	// Retrieve the plugin with server matching the requested plugin name
+	pluginWithServer := s.getPluginWithServer(ref.Plugin)
+	if pluginWithServer == nil {
+		return nil, status.Errorf(codes.Internal, "Unable get the plugin %v", ref.Plugin)
+	}
+	return pluginWithServer, nil
+}
+
+// WatchInstalledPackages streams the status of the installed packages of all
+// the plugins, or only of the plugin of the requested installed package.
+func (s packagesServer) WatchInstalledPackages(request *packages.WatchInstalledPackagesRequest, stream packages.PackagesService_WatchInstalledPackagesServer) error {
+	contextMsg := ""
+	if request.Context != nil {
+		contextMsg = fmt.Sprintf("(cluster=[%s], namespace=[%s])", request.Context.Cluster, request.Context.Namespace)
+	}
+
+	log.Infof("+core WatchInstalledPackages %s", contextMsg)
+
+	pluginsToWatch := s.plugins
+	if request.InstalledPackageRef != nil {
+		// Check prerequsites
+		if request.InstalledPackageRef.Context == nil {
+			return status.Errorf(codes.InvalidArgument, "Unable to retrieve the context (missing InstalledPackageRef.Context)")
+		}
+		if request.InstalledPackageRef.Identifier == "" {
+			return status.Errorf(codes.InvalidArgument, "Unable to retrieve the identifier (missing InstalledPackageRef.Identifier)")
+		}
+		if request.InstalledPackageRef.Plugin == nil {
+			return status.Errorf(codes.InvalidArgument, "Unable to retrieve the plugin (missing InstalledPackageRef.Plugin)")
+		}
+
+		// Retrieve the plugin with server matching the requested plugin name
+		pluginWithServer := s.getPluginWithServer(request.InstalledPackageRef.Plugin)
+		if pluginWithServer == nil {
+			return status.Errorf(codes.Internal, "Unable get the plugin %v", request.InstalledPackageRef.Plugin)
+		}
+		pluginsToWatch = []*pkgsPluginWithServer{pluginWithServer}
+	}
+
+	// The plugins watch concurrently until the client goes away or one of
+	// them fails, in which case the others are stopped. The plugin timeout does
+	// not apply as the watches are long-lived.
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	var sendMutex sync.Mutex
+	errs := make(chan error, len(pluginsToWatch))
+	for _, p := range pluginsToWatch {
+		go func(p *pkgsPluginWithServer) {
+			err := p.server.WatchInstalledPackages(request, &pluginWatchStream{
+				PackagesService_WatchInstalledPackagesServer: stream,
+				ctx:       ctx,
+				plugin:    p.plugin,
+				sendMutex: &sendMutex,
+			})
+			if err != nil && status.Code(err) == codes.Unimplemented && request.InstalledPackageRef == nil {
+				// Not every plugin manages installed packages.
+				err = nil
+			}
+			if err != nil {
+				err = status.Errorf(status.Convert(err).Code(), "Unable to watch the installed packages using the plugin %v: %v", p.plugin.Name, err)
+			}
+			errs <- err
+		}(p)
+	}
+
+	var watchErr error
+	for range pluginsToWatch {
+		if err := <-errs; err != nil && watchErr == nil {
+			log.Errorf("%v", err)
+			watchErr = err
+			cancel()
+		}
+	}
+	return watchErr
+}
+
+// pluginWatchStream is the stream passed to each plugin when watching the
+// installed packages. It sets the plugin on the references sent and
+// serializes the sends of the plugins watching concurrently.
+type pluginWatchStream struct {
+	packages.PackagesService_WatchInstalledPackagesServer
+	ctx       context.Context
+	plugin    *v1alpha1.Plugin
+	sendMutex *sync.Mutex
+}
+
+func (s *pluginWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *pluginWatchStream) Send(response *packages.WatchInstalledPackagesResponse) error {
+	if response.InstalledPackageRef != nil {
+		response.InstalledPackageRef.Plugin = s.plugin
+	}
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+	return s.PackagesService_WatchInstalledPackagesServer.Send(response)
+}
+
+// pluginResult holds either the response or the error returned by a single
+// plugin when a request is aggregated across all the plugins.
+type pluginResult struct {
+	plugin   *v1alpha1.Plugin
+	response interface{}
+	err      error
+}
+
+// callAllPlugins calls the given function concurrently for every plugin, each
+// one limited by the configured plugin timeout, and returns the results in
+// the same order as the plugins.
+func (s packagesServer) callAllPlugins(ctx context.Context, call func(context.Context, *pkgsPluginWithServer) (interface{}, error)) []pluginResult {
+	results := make([]pluginResult, len(s.plugins))
+	var wg sync.WaitGroup
+	for i, p := range s.plugins {
+		wg.Add(1)
+		go func(i int, p *pkgsPluginWithServer) {
+			defer wg.Done()
+			results[i] = s.callPlugin(ctx, p, call)
+		}(i, p)
+	}
+	wg.Wait()
+	return results
+}
+
+// callPlugin calls the given function for a single plugin, returning as soon
+// as the plugin timeout expires even if the plugin ignores the cancellation
+// of its context.
+func (s packagesServer) callPlugin(ctx context.Context, p *pkgsPluginWithServer, call func(context.Context, *pkgsPluginWithServer) (interface{}, error)) pluginResult {
+	if s.pluginTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.pluginTimeout)
+		defer cancel()
+	}
+
+	resultCh := make(chan pluginResult, 1)
+	go func() {
+		response, err := call(ctx, p)
+		resultCh <- pluginResult{plugin: p.plugin, response: response, err: err}
+	}()
+
+	select {
+	case result := <-resultCh:
+		return result
+	case <-ctx.Done():
+		code := codes.Canceled
+		if ctx.Err() == context.DeadlineExceeded {
+			code = codes.DeadlineExceeded
+		}
+		return pluginResult{
+			plugin: p.plugin,
+			err:    status.Errorf(code, "The plugin %v did not respond: %v", p.plugin.Name, ctx.Err()),
+		}
+	}
+}
+
+// pluginErrorFromResult returns the PluginError for a failed plugin result.
+func pluginErrorFromResult(result pluginResult) *packages.PluginError {
+	st := status.Convert(result.err)
+	return &packages.PluginError{
+		Plugin:     result.plugin,
+		StatusCode: int32(st.Code()),
+		Message:    st.Message(),
+	}
+}
+
+// pluginErrorsMessage returns a single message summarizing the errors of
+// all the given plugins.
+func pluginErrorsMessage(pluginErrors []*packages.PluginError) string {
+	messages := make([]string, len(pluginErrors))
+	for i, e := range pluginErrors {
+		messages[i] = fmt.Sprintf("%s: %s", e.Plugin.GetName(), e.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// compositePageToken holds the offset of the next item for each plugin which
+// may still have results, keyed by plugin name. Plugins which are not included
+// in a non-empty token have no more results.
+type compositePageToken map[string]int
+
+// decodeCompositePageToken decodes a page token returned by the core API. An
+// empty page token starts at the first item of every plugin.
+func decodeCompositePageToken(pageToken string) (compositePageToken, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	bytes, err := base64.URLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	token := compositePageToken{}
+	if err = json.Unmarshal(bytes, &token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// offset returns the offset of the next item of the plugin, or false if the
+// plugin has no more results.
+func (t compositePageToken) offset(plugin *v1alpha1.Plugin) (int, bool) {
+	if t == nil {
+		return 0, true
+	}
+	offset, ok := t[plugin.Name]
+	return offset, ok
+}
+
+// encode returns the page token for the client, or an empty string if no
+// plugin has more results.
+func (t compositePageToken) encode() (string, error) {
+	if len(t) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(bytes), nil
+}
+
+// pluginPage is the number of results returned by a plugin for a page and
+// its own next page token.
+type pluginPage struct {
+	plugin        *v1alpha1.Plugin
+	count         int
+	nextPageToken string
+}
+
+// nextCompositePageToken advances the offset of each plugin by the number of
+// its results included in the page. Plugins which returned an error are not
+// included, so that a failing plugin does not prevent paging to the end.
+func nextCompositePageToken(pageToken compositePageToken, pluginPages []pluginPage, consumed map[string]int) (string, error) {
+	next := compositePageToken{}
+	for _, p := range pluginPages {
+		name := p.plugin.Name
+		if consumed[name] < p.count || p.nextPageToken != "" {
+			offset, _ := pageToken.offset(p.plugin)
+			next[name] = offset + consumed[name]
+		}
+	}
+	return next.encode()
+}
+
+// distinctSortedStrings returns the distinct values sorted alphabetically.
+func distinctSortedStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	seen := map[string]bool{}
+	distinct := []string{}
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			distinct = append(distinct, v)
+		}
+	}
+	sort.Strings(distinct)
+	return distinct
+}
+
 // getPluginWithServer returns the *pkgsPluginWithServer from a given packagesServer
 // matching the plugin name
 func (s packagesServer) getPluginWithServer(plugin *v1alpha1.Plugin) *pkgsPluginWithServer {
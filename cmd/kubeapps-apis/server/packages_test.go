@@ -0,0 +1,1351 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var mockedPackagingPlugin1 = &plugins.Plugin{Name: "mock1.packages", Version: "v1alpha1"}
+var mockedPackagingPlugin2 = &plugins.Plugin{Name: "mock2.packages", Version: "v1alpha1"}
+var mockedPackagingPlugin3 = &plugins.Plugin{Name: "mock3.packages", Version: "v1alpha1"}
+
+// fakePluginServer is a packages plugin whose responses are set by each test.
+type fakePluginServer struct {
+	packages.UnimplementedPackagesServiceServer
+
+	availablePackageSummaries []*packages.AvailablePackageSummary
+	installedPackageSummaries []*packages.InstalledPackageSummary
+	watchResponses            []*packages.WatchInstalledPackagesResponse
+	err                       error
+	// delay is the time the plugin takes to respond, ignoring the context.
+	delay time.Duration
+	// presorted plugins return the available package summaries in the order
+	// given, as a plugin paginating in a database would, instead of sorting them.
+	presorted bool
+}
+
+func (s fakePluginServer) GetAvailablePackageSummaries(ctx context.Context, request *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error) {
+	time.Sleep(s.delay)
+	if s.err != nil {
+		return nil, s.err
+	}
+	// Sort and page through the summaries using the item offset as the page
+	// token, as the plugins do.
+	pkgs := append([]*packages.AvailablePackageSummary{}, s.availablePackageSummaries...)
+	if !s.presorted {
+		SortAvailablePackageSummaries(pkgs, request.GetSortOptions())
+	}
+	offset, _ := strconv.Atoi(request.GetPaginationOptions().GetPageToken())
+	if offset > len(pkgs) {
+		offset = len(pkgs)
+	}
+	pkgs = pkgs[offset:]
+	nextPageToken := ""
+	if pageSize := int(request.GetPaginationOptions().GetPageSize()); pageSize > 0 && len(pkgs) > pageSize {
+		pkgs = pkgs[:pageSize]
+		nextPageToken = fmt.Sprintf("%d", offset+pageSize)
+	}
+	return &packages.GetAvailablePackageSummariesResponse{
+		AvailablePackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
+	}, nil
+}
+
+func (s fakePluginServer) GetInstalledPackageSummaries(ctx context.Context, request *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.installedPackageSummaries == nil {
+		return s.UnimplementedPackagesServiceServer.GetInstalledPackageSummaries(ctx, request)
+	}
+	pkgs := append([]*packages.InstalledPackageSummary{}, s.installedPackageSummaries...)
+	SortInstalledPackageSummaries(pkgs)
+	offset, _ := strconv.Atoi(request.GetPaginationOptions().GetPageToken())
+	if offset > len(pkgs) {
+		offset = len(pkgs)
+	}
+	pkgs = pkgs[offset:]
+	nextPageToken := ""
+	if pageSize := int(request.GetPaginationOptions().GetPageSize()); pageSize > 0 && len(pkgs) > pageSize {
+		pkgs = pkgs[:pageSize]
+		nextPageToken = fmt.Sprintf("%d", offset+pageSize)
+	}
+	return &packages.GetInstalledPackageSummariesResponse{
+		InstalledPackageSummaries: pkgs,
+		NextPageToken:             nextPageToken,
+	}, nil
+}
+
+func (s fakePluginServer) CreateInstalledPackage(ctx context.Context, request *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &packages.CreateInstalledPackageResponse{
+		InstalledPackageRef: &packages.InstalledPackageReference{
+			Context:    request.TargetContext,
+			Identifier: request.Name,
+		},
+	}, nil
+}
+
+func (s fakePluginServer) GetInstalledPackageResourceRefs(ctx context.Context, request *packages.GetInstalledPackageResourceRefsRequest) (*packages.GetInstalledPackageResourceRefsResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &packages.GetInstalledPackageResourceRefsResponse{
+		ResourceRefs: []*packages.ResourceRef{
+			{
+				ApiVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       request.GetInstalledPackageRef().GetIdentifier(),
+				Namespace:  request.GetInstalledPackageRef().GetContext().GetNamespace(),
+			},
+		},
+	}, nil
+}
+
+func (s fakePluginServer) RollbackInstalledPackage(ctx context.Context, request *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &packages.RollbackInstalledPackageResponse{
+		InstalledPackageRef: &packages.InstalledPackageReference{
+			Context:    request.GetInstalledPackageRef().GetContext(),
+			Identifier: request.GetInstalledPackageRef().GetIdentifier(),
+		},
+	}, nil
+}
+
+func (s fakePluginServer) RenderInstalledPackage(ctx context.Context, request *packages.RenderInstalledPackageRequest) (*packages.RenderInstalledPackageResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &packages.RenderInstalledPackageResponse{
+		Manifest: fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\n", request.GetName()),
+		ResourceRefs: []*packages.ResourceRef{
+			{
+				ApiVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       request.GetName(),
+				Namespace:  request.GetTargetContext().GetNamespace(),
+			},
+		},
+	}, nil
+}
+
+func (s fakePluginServer) DiffInstalledPackage(ctx context.Context, request *packages.DiffInstalledPackageRequest) (*packages.DiffInstalledPackageResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &packages.DiffInstalledPackageResponse{
+		ResourceDiffs: []*packages.ResourceDiff{
+			{
+				ResourceRef: &packages.ResourceRef{
+					ApiVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       request.GetInstalledPackageRef().GetIdentifier(),
+					Namespace:  request.GetInstalledPackageRef().GetContext().GetNamespace(),
+				},
+				DiffType: packages.ResourceDiff_DIFF_TYPE_ADDED,
+			},
+		},
+	}, nil
+}
+
+// WatchInstalledPackages sends the watch responses and returns, as if the
+// watch ended.
+func (s fakePluginServer) WatchInstalledPackages(request *packages.WatchInstalledPackagesRequest, stream packages.PackagesService_WatchInstalledPackagesServer) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.watchResponses == nil {
+		return s.UnimplementedPackagesServiceServer.WatchInstalledPackages(request, stream)
+	}
+	for _, response := range s.watchResponses {
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeRequiredPluginServer is a packages plugin implementing only the
+// operations which are required by the core API.
+type fakeRequiredPluginServer struct {
+	PackagesPluginServer
+}
+
+// fakeWatchStream collects the responses sent on a WatchInstalledPackages stream.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	responses []*packages.WatchInstalledPackagesResponse
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeWatchStream) Send(response *packages.WatchInstalledPackagesResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func makeAvailablePackageSummary(name string) *packages.AvailablePackageSummary {
+	return &packages.AvailablePackageSummary{
+		Name:        name,
+		DisplayName: name,
+		AvailablePackageRef: &packages.AvailablePackageReference{
+			Context:    &packages.Context{Namespace: "default"},
+			Identifier: name,
+		},
+	}
+}
+
+func TestGetAvailablePackageSummaries(t *testing.T) {
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		pluginTimeout     time.Duration
+		statusCode        codes.Code
+		expectedResponse  *packages.GetAvailablePackageSummariesResponse
+	}{
+		{
+			name: "it aggregates the results from all the plugins",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						availablePackageSummaries: []*packages.AvailablePackageSummary{
+							makeAvailablePackageSummary("pkg-1"),
+						},
+					},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{
+						availablePackageSummaries: []*packages.AvailablePackageSummary{
+							makeAvailablePackageSummary("pkg-2"),
+						},
+					},
+				},
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*packages.AvailablePackageSummary{
+					{
+						Name:        "pkg-1",
+						DisplayName: "pkg-1",
+						AvailablePackageRef: &packages.AvailablePackageReference{
+							Context:    &packages.Context{Namespace: "default"},
+							Identifier: "pkg-1",
+							Plugin:     mockedPackagingPlugin1,
+						},
+					},
+					{
+						Name:        "pkg-2",
+						DisplayName: "pkg-2",
+						AvailablePackageRef: &packages.AvailablePackageReference{
+							Context:    &packages.Context{Namespace: "default"},
+							Identifier: "pkg-2",
+							Plugin:     mockedPackagingPlugin2,
+						},
+					},
+				},
+				PluginErrors: []*packages.PluginError{},
+			},
+		},
+		{
+			name: "it returns partial results with the error of a failing plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						availablePackageSummaries: []*packages.AvailablePackageSummary{
+							makeAvailablePackageSummary("pkg-1"),
+						},
+					},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{
+						err: status.Errorf(codes.FailedPrecondition, "boom"),
+					},
+				},
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*packages.AvailablePackageSummary{
+					{
+						Name:        "pkg-1",
+						DisplayName: "pkg-1",
+						AvailablePackageRef: &packages.AvailablePackageReference{
+							Context:    &packages.Context{Namespace: "default"},
+							Identifier: "pkg-1",
+							Plugin:     mockedPackagingPlugin1,
+						},
+					},
+				},
+				PluginErrors: []*packages.PluginError{
+					{
+						Plugin:     mockedPackagingPlugin2,
+						StatusCode: int32(codes.FailedPrecondition),
+						Message:    "boom",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns partial results when a plugin times out",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						availablePackageSummaries: []*packages.AvailablePackageSummary{
+							makeAvailablePackageSummary("pkg-1"),
+						},
+					},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{
+						delay: time.Second,
+					},
+				},
+			},
+			pluginTimeout: 10 * time.Millisecond,
+			statusCode:    codes.OK,
+			expectedResponse: &packages.GetAvailablePackageSummariesResponse{
+				AvailablePackageSummaries: []*packages.AvailablePackageSummary{
+					{
+						Name:        "pkg-1",
+						DisplayName: "pkg-1",
+						AvailablePackageRef: &packages.AvailablePackageReference{
+							Context:    &packages.Context{Namespace: "default"},
+							Identifier: "pkg-1",
+							Plugin:     mockedPackagingPlugin1,
+						},
+					},
+				},
+				PluginErrors: []*packages.PluginError{
+					{
+						Plugin:     mockedPackagingPlugin2,
+						StatusCode: int32(codes.DeadlineExceeded),
+						Message:    "The plugin mock2.packages did not respond: context deadline exceeded",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns an error when every plugin fails",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						err: status.Errorf(codes.PermissionDenied, "forbidden"),
+					},
+				},
+			},
+			statusCode: codes.PermissionDenied,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.GetAvailablePackageSummariesResponse{},
+		packages.AvailablePackageSummary{},
+		packages.AvailablePackageReference{},
+		packages.Context{},
+		packages.PluginError{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(tc.configuredPlugins, tc.pluginTimeout)
+
+			response, err := server.GetAvailablePackageSummaries(context.Background(), &packages.GetAvailablePackageSummariesRequest{})
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestGetAvailablePackageSummariesPagination(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{
+				availablePackageSummaries: []*packages.AvailablePackageSummary{
+					makeAvailablePackageSummary("apache"),
+					makeAvailablePackageSummary("mariadb"),
+					makeAvailablePackageSummary("zookeeper"),
+				},
+			},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				availablePackageSummaries: []*packages.AvailablePackageSummary{
+					makeAvailablePackageSummary("kafka"),
+					makeAvailablePackageSummary("nginx"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		sortOptions   *packages.SortOptions
+		pageSize      int32
+		expectedPages [][]string
+	}{
+		{
+			name:     "it merges the pages of all the plugins by name",
+			pageSize: 2,
+			expectedPages: [][]string{
+				{"apache", "kafka"},
+				{"mariadb", "nginx"},
+				{"zookeeper"},
+			},
+		},
+		{
+			name:        "it merges the pages of all the plugins in descending order",
+			sortOptions: &packages.SortOptions{Field: packages.SortOptions_SORT_FIELD_NAME, Descending: true},
+			pageSize:    3,
+			expectedPages: [][]string{
+				{"zookeeper", "nginx", "mariadb"},
+				{"kafka", "apache"},
+			},
+		},
+		{
+			name: "it returns a single page without a page size",
+			expectedPages: [][]string{
+				{"apache", "kafka", "mariadb", "nginx", "zookeeper"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			pages := [][]string{}
+			pageToken := ""
+			for {
+				response, err := server.GetAvailablePackageSummaries(context.Background(), &packages.GetAvailablePackageSummariesRequest{
+					PaginationOptions: &packages.PaginationOptions{
+						PageSize:  tc.pageSize,
+						PageToken: pageToken,
+					},
+					SortOptions: tc.sortOptions,
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				names := []string{}
+				for _, pkg := range response.AvailablePackageSummaries {
+					names = append(names, pkg.Name)
+				}
+				pages = append(pages, names)
+
+				pageToken = response.NextPageToken
+				if pageToken == "" || len(pages) > len(tc.expectedPages) {
+					break
+				}
+			}
+
+			if got, want := pages, tc.expectedPages; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+
+	t.Run("it returns an error for an invalid page token", func(t *testing.T) {
+		server := NewPackagesServer(configuredPlugins, 0)
+
+		_, err := server.GetAvailablePackageSummaries(context.Background(), &packages.GetAvailablePackageSummariesRequest{
+			PaginationOptions: &packages.PaginationOptions{PageSize: 2, PageToken: "not-a-token"},
+		})
+
+		if got, want := status.Code(err), codes.InvalidArgument; got != want {
+			t.Errorf("got: %+v, want: %+v", got, want)
+		}
+	})
+}
+
+func makeVersionedAvailablePackageSummary(name, namespace, version string) *packages.AvailablePackageSummary {
+	pkg := makeAvailablePackageSummary(name)
+	pkg.AvailablePackageRef.Context.Namespace = namespace
+	pkg.LatestVersion = &packages.PackageAppVersion{PkgVersion: version}
+	return pkg
+}
+
+// TestGetAvailablePackageSummariesPaginationOrder checks that the core merges
+// the pages of plugins which sort in the assets database, in the order given by
+// its ORDER BY clause, without skipping or repeating any package.
+func TestGetAvailablePackageSummariesPaginationOrder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sortOptions   *packages.SortOptions
+		plugin1Pkgs   []*packages.AvailablePackageSummary
+		plugin2Pkgs   []*packages.AvailablePackageSummary
+		expectedPages [][]string
+	}{
+		{
+			name: "it compares names bytewise, breaking ties by namespace",
+			plugin1Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("Apache", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("Zookeeper", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("apache", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("apache", "other", "1.0.0"),
+			},
+			plugin2Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("Kafka", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("apache", "kubeapps", "1.0.0"),
+				makeVersionedAvailablePackageSummary("kafka", "default", "1.0.0"),
+			},
+			expectedPages: [][]string{
+				{"Apache/default", "Kafka/default"},
+				{"Zookeeper/default", "apache/default"},
+				{"apache/kubeapps", "apache/other"},
+				{"kafka/default"},
+			},
+		},
+		{
+			name:        "it sorts prereleases before their release and invalid versions last",
+			sortOptions: &packages.SortOptions{Field: packages.SortOptions_SORT_FIELD_LATEST_VERSION},
+			plugin1Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("a", "default", "1.0.0-alpha"),
+				makeVersionedAvailablePackageSummary("c", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("e", "default", "1.10.0"),
+				makeVersionedAvailablePackageSummary("g", "default", "latest"),
+			},
+			plugin2Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("b", "default", "1.0.0-rc.1"),
+				makeVersionedAvailablePackageSummary("d", "default", "v1.2.0"),
+				makeVersionedAvailablePackageSummary("f", "default", "10.0.0-beta+build.1"),
+			},
+			expectedPages: [][]string{
+				{"a/default", "b/default"},
+				{"c/default", "d/default"},
+				{"e/default", "f/default"},
+				{"g/default"},
+			},
+		},
+		{
+			name:        "it keeps invalid versions last in descending order",
+			sortOptions: &packages.SortOptions{Field: packages.SortOptions_SORT_FIELD_LATEST_VERSION, Descending: true},
+			plugin1Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("e", "default", "1.10.0"),
+				makeVersionedAvailablePackageSummary("c", "default", "1.0.0"),
+				makeVersionedAvailablePackageSummary("a", "default", "1.0.0-alpha"),
+				makeVersionedAvailablePackageSummary("g", "default", "latest"),
+			},
+			plugin2Pkgs: []*packages.AvailablePackageSummary{
+				makeVersionedAvailablePackageSummary("f", "default", "10.0.0-beta+build.1"),
+				makeVersionedAvailablePackageSummary("d", "default", "v1.2.0"),
+				makeVersionedAvailablePackageSummary("b", "default", "1.0.0-rc.1"),
+			},
+			expectedPages: [][]string{
+				{"f/default", "e/default"},
+				{"d/default", "c/default"},
+				{"b/default", "a/default"},
+				{"g/default"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer([]*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{availablePackageSummaries: tc.plugin1Pkgs, presorted: true},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{availablePackageSummaries: tc.plugin2Pkgs, presorted: true},
+				},
+			}, 0)
+
+			pages := [][]string{}
+			pageToken := ""
+			for {
+				response, err := server.GetAvailablePackageSummaries(context.Background(), &packages.GetAvailablePackageSummariesRequest{
+					PaginationOptions: &packages.PaginationOptions{
+						PageSize:  2,
+						PageToken: pageToken,
+					},
+					SortOptions: tc.sortOptions,
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				names := []string{}
+				for _, pkg := range response.AvailablePackageSummaries {
+					names = append(names, pkg.Name+"/"+pkg.GetAvailablePackageRef().GetContext().GetNamespace())
+				}
+				pages = append(pages, names)
+
+				pageToken = response.NextPageToken
+				if pageToken == "" || len(pages) > len(tc.expectedPages) {
+					break
+				}
+			}
+
+			if got, want := pages, tc.expectedPages; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func makeInstalledPackageSummary(name, namespace string) *packages.InstalledPackageSummary {
+	return &packages.InstalledPackageSummary{
+		Name: name,
+		InstalledPackageRef: &packages.InstalledPackageReference{
+			Context:    &packages.Context{Namespace: namespace},
+			Identifier: name,
+		},
+	}
+}
+
+func TestGetInstalledPackageSummaries(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{
+				installedPackageSummaries: []*packages.InstalledPackageSummary{
+					makeInstalledPackageSummary("my-wordpress", "default"),
+					makeInstalledPackageSummary("my-apache", "default"),
+				},
+			},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				installedPackageSummaries: []*packages.InstalledPackageSummary{
+					makeInstalledPackageSummary("my-apache", "other"),
+					makeInstalledPackageSummary("my-mariadb", "default"),
+				},
+			},
+		},
+		{
+			// A plugin which does not implement installed packages is ignored.
+			plugin: &plugins.Plugin{Name: "mock3.packages", Version: "v1alpha1"},
+			server: fakePluginServer{},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		pageSize      int32
+		expectedPages [][]string
+	}{
+		{
+			name: "it merges the installed packages of all the plugins by name",
+			expectedPages: [][]string{
+				{"mock1.packages/default/my-apache", "mock2.packages/other/my-apache", "mock2.packages/default/my-mariadb", "mock1.packages/default/my-wordpress"},
+			},
+		},
+		{
+			name:     "it merges the pages of all the plugins",
+			pageSize: 3,
+			expectedPages: [][]string{
+				{"mock1.packages/default/my-apache", "mock2.packages/other/my-apache", "mock2.packages/default/my-mariadb"},
+				{"mock1.packages/default/my-wordpress"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			pages := [][]string{}
+			pageToken := ""
+			for {
+				response, err := server.GetInstalledPackageSummaries(context.Background(), &packages.GetInstalledPackageSummariesRequest{
+					PaginationOptions: &packages.PaginationOptions{
+						PageSize:  tc.pageSize,
+						PageToken: pageToken,
+					},
+				})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if got, want := len(response.PluginErrors), 0; got != want {
+					t.Errorf("got: %d, want: %d, errors: %+v", got, want, response.PluginErrors)
+				}
+				pkgs := []string{}
+				for _, pkg := range response.InstalledPackageSummaries {
+					ref := pkg.InstalledPackageRef
+					pkgs = append(pkgs, ref.Plugin.Name+"/"+ref.Context.Namespace+"/"+ref.Identifier)
+				}
+				pages = append(pages, pkgs)
+
+				pageToken = response.NextPageToken
+				if pageToken == "" || len(pages) > len(tc.expectedPages) {
+					break
+				}
+			}
+
+			if got, want := pages, tc.expectedPages; !cmp.Equal(got, want) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestCreateInstalledPackage(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				err: status.Errorf(codes.NotFound, "chart not found"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		request          *packages.CreateInstalledPackageRequest
+		statusCode       codes.Code
+		expectedResponse *packages.CreateInstalledPackageResponse
+	}{
+		{
+			name: "it routes the request to the plugin of the available package",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Context:    &packages.Context{Namespace: "kubeapps"},
+					Identifier: "bitnami/apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+				TargetContext: &packages.Context{Namespace: "default"},
+				Name:          "my-apache",
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.CreateInstalledPackageResponse{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+			},
+		},
+		{
+			name: "it returns the status code of the plugin error",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Context:    &packages.Context{Namespace: "kubeapps"},
+					Identifier: "bitnami/apache",
+					Plugin:     mockedPackagingPlugin2,
+				},
+				TargetContext: &packages.Context{Namespace: "default"},
+				Name:          "my-apache",
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it returns invalid argument if the plugin is missing",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Context:    &packages.Context{Namespace: "kubeapps"},
+					Identifier: "bitnami/apache",
+				},
+				TargetContext: &packages.Context{Namespace: "default"},
+				Name:          "my-apache",
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns invalid argument if the name is missing",
+			request: &packages.CreateInstalledPackageRequest{
+				AvailablePackageRef: &packages.AvailablePackageReference{
+					Context:    &packages.Context{Namespace: "kubeapps"},
+					Identifier: "bitnami/apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+				TargetContext: &packages.Context{Namespace: "default"},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.CreateInstalledPackageResponse{},
+		packages.InstalledPackageReference{},
+		packages.Context{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			response, err := server.CreateInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestGetInstalledPackageResourceRefs(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				err: status.Errorf(codes.NotFound, "release not found"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		request          *packages.GetInstalledPackageResourceRefsRequest
+		statusCode       codes.Code
+		expectedResponse *packages.GetInstalledPackageResourceRefsResponse
+	}{
+		{
+			name: "it routes the request to the plugin of the installed package",
+			request: &packages.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.GetInstalledPackageResourceRefsResponse{
+				ResourceRefs: []*packages.ResourceRef{
+					{
+						ApiVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-apache",
+						Namespace:  "default",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns the status code of the plugin error",
+			request: &packages.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin2,
+				},
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it returns invalid argument if the plugin is missing",
+			request: &packages.GetInstalledPackageResourceRefsRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.GetInstalledPackageResourceRefsResponse{},
+		packages.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			response, err := server.GetInstalledPackageResourceRefs(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestRollbackInstalledPackage(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				err: status.Errorf(codes.NotFound, "revision not found"),
+			},
+		},
+		{
+			plugin: mockedPackagingPlugin3,
+			server: fakeRequiredPluginServer{fakePluginServer{}},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		request          *packages.RollbackInstalledPackageRequest
+		statusCode       codes.Code
+		expectedResponse *packages.RollbackInstalledPackageResponse
+	}{
+		{
+			name: "it routes the request to the plugin of the installed package",
+			request: &packages.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+				Revision: 1,
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.RollbackInstalledPackageResponse{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+			},
+		},
+		{
+			name: "it returns the status code of the plugin error",
+			request: &packages.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin2,
+				},
+				Revision: 1,
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it returns unimplemented if the plugin does not support revisions",
+			request: &packages.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin3,
+				},
+				Revision: 1,
+			},
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name: "it returns invalid argument if the revision is missing",
+			request: &packages.RollbackInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.RollbackInstalledPackageResponse{},
+		packages.InstalledPackageReference{},
+		packages.Context{},
+		plugins.Plugin{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			response, err := server.RollbackInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestRenderInstalledPackage(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				err: status.Errorf(codes.InvalidArgument, "invalid values"),
+			},
+		},
+		{
+			plugin: mockedPackagingPlugin3,
+			server: fakeRequiredPluginServer{fakePluginServer{}},
+		},
+	}
+
+	availablePackageRef := func(plugin *plugins.Plugin) *packages.AvailablePackageReference {
+		return &packages.AvailablePackageReference{
+			Context:    &packages.Context{Namespace: "kubeapps"},
+			Identifier: "bitnami/apache",
+			Plugin:     plugin,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		request          *packages.RenderInstalledPackageRequest
+		statusCode       codes.Code
+		expectedResponse *packages.RenderInstalledPackageResponse
+	}{
+		{
+			name: "it routes the request to the plugin of the available package",
+			request: &packages.RenderInstalledPackageRequest{
+				AvailablePackageRef: availablePackageRef(mockedPackagingPlugin1),
+				TargetContext:       &packages.Context{Namespace: "default"},
+				Name:                "my-apache",
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.RenderInstalledPackageResponse{
+				Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-apache\n",
+				ResourceRefs: []*packages.ResourceRef{
+					{
+						ApiVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-apache",
+						Namespace:  "default",
+					},
+				},
+			},
+		},
+		{
+			name: "it returns the status code of the plugin error",
+			request: &packages.RenderInstalledPackageRequest{
+				AvailablePackageRef: availablePackageRef(mockedPackagingPlugin2),
+				TargetContext:       &packages.Context{Namespace: "default"},
+				Name:                "my-apache",
+			},
+			statusCode: codes.InvalidArgument,
+		},
+		{
+			name: "it returns unimplemented if the plugin does not support rendering",
+			request: &packages.RenderInstalledPackageRequest{
+				AvailablePackageRef: availablePackageRef(mockedPackagingPlugin3),
+				TargetContext:       &packages.Context{Namespace: "default"},
+				Name:                "my-apache",
+			},
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name: "it returns invalid argument if the name is missing",
+			request: &packages.RenderInstalledPackageRequest{
+				AvailablePackageRef: availablePackageRef(mockedPackagingPlugin1),
+				TargetContext:       &packages.Context{Namespace: "default"},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.RenderInstalledPackageResponse{},
+		packages.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			response, err := server.RenderInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestDiffInstalledPackage(t *testing.T) {
+	configuredPlugins := []*pkgsPluginWithServer{
+		{
+			plugin: mockedPackagingPlugin1,
+			server: fakePluginServer{},
+		},
+		{
+			plugin: mockedPackagingPlugin2,
+			server: fakePluginServer{
+				err: status.Errorf(codes.NotFound, "release not found"),
+			},
+		},
+		{
+			plugin: mockedPackagingPlugin3,
+			server: fakeRequiredPluginServer{fakePluginServer{}},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		request          *packages.DiffInstalledPackageRequest
+		statusCode       codes.Code
+		expectedResponse *packages.DiffInstalledPackageResponse
+	}{
+		{
+			name: "it routes the request to the plugin of the installed package",
+			request: &packages.DiffInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin1,
+				},
+			},
+			statusCode: codes.OK,
+			expectedResponse: &packages.DiffInstalledPackageResponse{
+				ResourceDiffs: []*packages.ResourceDiff{
+					{
+						ResourceRef: &packages.ResourceRef{
+							ApiVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "my-apache",
+							Namespace:  "default",
+						},
+						DiffType: packages.ResourceDiff_DIFF_TYPE_ADDED,
+					},
+				},
+			},
+		},
+		{
+			name: "it returns the status code of the plugin error",
+			request: &packages.DiffInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin2,
+				},
+			},
+			statusCode: codes.NotFound,
+		},
+		{
+			name: "it returns unimplemented if the plugin does not support diffing",
+			request: &packages.DiffInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+					Plugin:     mockedPackagingPlugin3,
+				},
+			},
+			statusCode: codes.Unimplemented,
+		},
+		{
+			name: "it returns invalid argument if the plugin is missing",
+			request: &packages.DiffInstalledPackageRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-apache",
+				},
+			},
+			statusCode: codes.InvalidArgument,
+		},
+	}
+
+	ignoredUnexported := cmpopts.IgnoreUnexported(
+		packages.DiffInstalledPackageResponse{},
+		packages.ResourceDiff{},
+		packages.ResourceRef{},
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(configuredPlugins, 0)
+
+			response, err := server.DiffInstalledPackage(context.Background(), tc.request)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				if got, want := response, tc.expectedResponse; !cmp.Equal(got, want, ignoredUnexported) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, ignoredUnexported))
+				}
+			}
+		})
+	}
+}
+
+func TestWatchInstalledPackages(t *testing.T) {
+	watchResponse := func(name string) *packages.WatchInstalledPackagesResponse {
+		return &packages.WatchInstalledPackagesResponse{
+			InstalledPackageRef: &packages.InstalledPackageReference{
+				Context:    &packages.Context{Namespace: "default"},
+				Identifier: name,
+			},
+			Status: &packages.InstalledPackageStatus{Ready: true},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		configuredPlugins []*pkgsPluginWithServer
+		request           *packages.WatchInstalledPackagesRequest
+		statusCode        codes.Code
+		expectedPlugins   map[string]string
+	}{
+		{
+			name: "it streams the statuses of all the plugins",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						watchResponses: []*packages.WatchInstalledPackagesResponse{watchResponse("my-apache")},
+					},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{
+						watchResponses: []*packages.WatchInstalledPackagesResponse{watchResponse("my-mariadb")},
+					},
+				},
+				{
+					// A plugin which does not implement installed packages is ignored.
+					plugin: &plugins.Plugin{Name: "mock3.packages", Version: "v1alpha1"},
+					server: fakePluginServer{},
+				},
+			},
+			request:    &packages.WatchInstalledPackagesRequest{},
+			statusCode: codes.OK,
+			expectedPlugins: map[string]string{
+				"my-apache":  mockedPackagingPlugin1.Name,
+				"my-mariadb": mockedPackagingPlugin2.Name,
+			},
+		},
+		{
+			name: "it only streams the statuses of the plugin of the installed package",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						watchResponses: []*packages.WatchInstalledPackagesResponse{watchResponse("my-apache")},
+					},
+				},
+				{
+					plugin: mockedPackagingPlugin2,
+					server: fakePluginServer{
+						watchResponses: []*packages.WatchInstalledPackagesResponse{watchResponse("my-mariadb")},
+					},
+				},
+			},
+			request: &packages.WatchInstalledPackagesRequest{
+				InstalledPackageRef: &packages.InstalledPackageReference{
+					Context:    &packages.Context{Namespace: "default"},
+					Identifier: "my-mariadb",
+					Plugin:     mockedPackagingPlugin2,
+				},
+			},
+			statusCode: codes.OK,
+			expectedPlugins: map[string]string{
+				"my-mariadb": mockedPackagingPlugin2.Name,
+			},
+		},
+		{
+			name: "it returns the error of a failing plugin",
+			configuredPlugins: []*pkgsPluginWithServer{
+				{
+					plugin: mockedPackagingPlugin1,
+					server: fakePluginServer{
+						err: status.Errorf(codes.PermissionDenied, "forbidden"),
+					},
+				},
+			},
+			request:    &packages.WatchInstalledPackagesRequest{},
+			statusCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := NewPackagesServer(tc.configuredPlugins, 0)
+			stream := &fakeWatchStream{}
+
+			err := server.WatchInstalledPackages(tc.request, stream)
+
+			if got, want := status.Code(err), tc.statusCode; got != want {
+				t.Fatalf("got: %+v, want: %+v, err: %+v", got, want, err)
+			}
+
+			if tc.statusCode == codes.OK {
+				gotPlugins := map[string]string{}
+				for _, response := range stream.responses {
+					gotPlugins[response.InstalledPackageRef.Identifier] = response.InstalledPackageRef.Plugin.GetName()
+				}
+				if got, want := gotPlugins, tc.expectedPlugins; !cmp.Equal(got, want) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+}
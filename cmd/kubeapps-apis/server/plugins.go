@@ -28,6 +28,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/pkg/agent"
 	"github.com/kubeapps/kubeapps/pkg/kube"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	log "k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -49,10 +51,83 @@ const (
 // KubernetesConfigGetter is a function type used by plugins to get a k8s config
 type KubernetesConfigGetter func(ctx context.Context, cluster string) (*rest.Config, error)
 
+// PluginsConfig is the configuration passed to the plugins when registering
+// them, read from the YAML or JSON file at --plugin-config-path.
+type PluginsConfig struct {
+	// Helm is the configuration shared by the plugins managing Helm releases.
+	Helm HelmPluginsConfig `json:"helm"`
+}
+
+// HelmPluginsConfig is the configuration of the plugins managing Helm releases.
+type HelmPluginsConfig struct {
+	// StorageDriver is the storage of the Helm releases, as for the
+	// --helm-driver option of kubeops: "secrets" (the default), "configmaps"
+	// or "sql". The connection string of the SQL storage is read from the
+	// HELM_DRIVER_SQL_CONNECTION_STRING environment variable.
+	StorageDriver string `json:"storageDriver,omitempty"`
+}
+
+// StorageForDriver returns the function creating the configured storage for
+// Helm releases on the given clusters.
+//
+// The memory storage is created empty for every request, so it is rejected.
+// The SQL storage only records the namespace of the releases, so it cannot
+// tell apart the releases of different clusters and is rejected when more
+// than one cluster is configured.
+func (c HelmPluginsConfig) StorageForDriver(clustersConfig kube.ClustersConfig) (agent.StorageForDriver, error) {
+	if c.StorageDriver == "" {
+		return agent.StorageForSecrets, nil
+	}
+	if c.StorageDriver == "memory" {
+		return nil, fmt.Errorf("the memory Helm driver cannot be used, as it would not keep the releases between requests")
+	}
+	if c.StorageDriver == "sql" && len(clustersConfig.Clusters) > 1 {
+		return nil, fmt.Errorf("the sql Helm driver cannot be used with more than one cluster, as it stores the releases of all the clusters by namespace only")
+	}
+	return agent.ParseDriverType(c.StorageDriver)
+}
+
+// PackagesPluginServer is the part of the core packages.v1alpha1 API which a
+// plugin must implement to be registered for aggregation. The optional
+// operations are defined by separate interfaces, for which the core API returns
+// Unimplemented when the plugin does not implement them.
+type PackagesPluginServer interface {
+	GetAvailablePackageSummaries(context.Context, *packages.GetAvailablePackageSummariesRequest) (*packages.GetAvailablePackageSummariesResponse, error)
+	GetAvailablePackageDetail(context.Context, *packages.GetAvailablePackageDetailRequest) (*packages.GetAvailablePackageDetailResponse, error)
+	GetAvailablePackageVersions(context.Context, *packages.GetAvailablePackageVersionsRequest) (*packages.GetAvailablePackageVersionsResponse, error)
+	GetInstalledPackageSummaries(context.Context, *packages.GetInstalledPackageSummariesRequest) (*packages.GetInstalledPackageSummariesResponse, error)
+	GetInstalledPackageDetail(context.Context, *packages.GetInstalledPackageDetailRequest) (*packages.GetInstalledPackageDetailResponse, error)
+	CreateInstalledPackage(context.Context, *packages.CreateInstalledPackageRequest) (*packages.CreateInstalledPackageResponse, error)
+	UpdateInstalledPackage(context.Context, *packages.UpdateInstalledPackageRequest) (*packages.UpdateInstalledPackageResponse, error)
+	DeleteInstalledPackage(context.Context, *packages.DeleteInstalledPackageRequest) (*packages.DeleteInstalledPackageResponse, error)
+	GetInstalledPackageResourceRefs(context.Context, *packages.GetInstalledPackageResourceRefsRequest) (*packages.GetInstalledPackageResourceRefsResponse, error)
+	GetInstalledPackageResourceStatuses(context.Context, *packages.GetInstalledPackageResourceStatusesRequest) (*packages.GetInstalledPackageResourceStatusesResponse, error)
+	WatchInstalledPackages(*packages.WatchInstalledPackagesRequest, packages.PackagesService_WatchInstalledPackagesServer) error
+}
+
+// PackageRevisionsPluginServer is implemented by the plugins which keep the
+// revision history of their installed packages.
+type PackageRevisionsPluginServer interface {
+	GetInstalledPackageRevisions(context.Context, *packages.GetInstalledPackageRevisionsRequest) (*packages.GetInstalledPackageRevisionsResponse, error)
+	RollbackInstalledPackage(context.Context, *packages.RollbackInstalledPackageRequest) (*packages.RollbackInstalledPackageResponse, error)
+}
+
+// PackageRenderPluginServer is implemented by the plugins which can render the
+// manifest of a package without installing it.
+type PackageRenderPluginServer interface {
+	RenderInstalledPackage(context.Context, *packages.RenderInstalledPackageRequest) (*packages.RenderInstalledPackageResponse, error)
+}
+
+// PackageDiffPluginServer is implemented by the plugins which can compare the
+// resources of an installed package with those of a proposed update.
+type PackageDiffPluginServer interface {
+	DiffInstalledPackage(context.Context, *packages.DiffInstalledPackageRequest) (*packages.DiffInstalledPackageResponse, error)
+}
+
 // pkgsPluginWithServer stores the plugin detail together with its implementation.
 type pkgsPluginWithServer struct {
 	plugin *plugins.Plugin
-	server packages.PackagesServiceServer
+	server PackagesPluginServer
 }
 
 // coreServer implements the API defined in cmd/kubeapps-api-service/core/core.proto
@@ -70,6 +145,9 @@ type pluginsServer struct {
 
 	// The parsed config for clusters in a multi-cluster setup.
 	clustersConfig kube.ClustersConfig
+
+	// The parsed config passed to the plugins.
+	pluginsConfig PluginsConfig
 }
 
 func NewPluginsServer(serveOpts ServeOptions, registrar grpc.ServiceRegistrar, gwArgs gwHandlerArgs) (*pluginsServer, error) {
@@ -90,6 +168,12 @@ func NewPluginsServer(serveOpts ServeOptions, registrar grpc.ServiceRegistrar, g
 	}
 	ps.clustersConfig = clustersConfig
 
+	pluginsConfig, err := getPluginsConfigFromServeOpts(serveOpts, clustersConfig)
+	if err != nil {
+		return nil, err
+	}
+	ps.pluginsConfig = pluginsConfig
+
 	pluginDetails, err := ps.registerPlugins(pluginPaths, registrar, gwArgs, serveOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register plugins: %w", err)
@@ -158,17 +242,17 @@ func (s *pluginsServer) registerGRPC(p *plugin.Plugin, pluginDetail *plugins.Plu
 	if err != nil {
 		return fmt.Errorf("unable to lookup %q for %v: %w", grpcRegisterFunction, pluginDetail, err)
 	}
-	type grpcRegisterFunctionType = func(grpc.ServiceRegistrar, KubernetesConfigGetter, kube.ClustersConfig) (interface{}, error)
+	type grpcRegisterFunctionType = func(grpc.ServiceRegistrar, KubernetesConfigGetter, kube.ClustersConfig, PluginsConfig) (interface{}, error)
 
 	grpcFn, ok := grpcRegFn.(grpcRegisterFunctionType)
 	if !ok {
-		var dummyFn grpcRegisterFunctionType = func(grpc.ServiceRegistrar, KubernetesConfigGetter, kube.ClustersConfig) (interface{}, error) {
+		var dummyFn grpcRegisterFunctionType = func(grpc.ServiceRegistrar, KubernetesConfigGetter, kube.ClustersConfig, PluginsConfig) (interface{}, error) {
 			return nil, nil
 		}
 		return fmt.Errorf("unable to use %q in plugin %v due to mismatched signature.\nwant: %T\ngot: %T", grpcRegisterFunction, pluginDetail, dummyFn, grpcRegFn)
 	}
 
-	server, err := grpcFn(registrar, clientGetter, s.clustersConfig)
+	server, err := grpcFn(registrar, clientGetter, s.clustersConfig, s.pluginsConfig)
 	if err != nil {
 		return fmt.Errorf("plug-in %q failed to register due to: %v", pluginDetail, err)
 	} else if server == nil {
@@ -186,10 +270,10 @@ func (s *pluginsServer) registerPluginsSatisfyingCoreAPIs(pluginSrv interface{},
 	// grpc-go itself does, see:
 	// https://github.com/grpc/grpc-go/blob/v1.38.0/server.go#L621
 	serverType := reflect.TypeOf(pluginSrv)
-	corePackagesType := reflect.TypeOf((*packages.PackagesServiceServer)(nil)).Elem()
+	corePackagesType := reflect.TypeOf((*PackagesPluginServer)(nil)).Elem()
 
 	if serverType.Implements(corePackagesType) {
-		pkgsSrv, ok := pluginSrv.(packages.PackagesServiceServer)
+		pkgsSrv, ok := pluginSrv.(PackagesPluginServer)
 		if !ok {
 			return fmt.Errorf("Unable to convert plugin %v to core PackagesServicesServer although it implements the same.", pluginDetail)
 		}
@@ -379,3 +463,24 @@ func getClustersConfigFromServeOpts(serveOpts ServeOptions) (kube.ClustersConfig
 	defer cleanupCAFiles()
 	return config, nil
 }
+
+// getPluginsConfigFromServeOpts reads the config passed to the plugins from
+// the file at the PluginConfigPath of the serveOptions, if any, and checks it
+// can be used with the configured clusters.
+func getPluginsConfigFromServeOpts(serveOpts ServeOptions, clustersConfig kube.ClustersConfig) (PluginsConfig, error) {
+	config := PluginsConfig{}
+	if serveOpts.PluginConfigPath == "" {
+		return config, nil
+	}
+	content, err := ioutil.ReadFile(serveOpts.PluginConfigPath)
+	if err != nil {
+		return PluginsConfig{}, fmt.Errorf("unable to read the plugins config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(content, &config); err != nil {
+		return PluginsConfig{}, fmt.Errorf("unable to parse the plugins config: %w", err)
+	}
+	if _, err := config.Helm.StorageForDriver(clustersConfig); err != nil {
+		return PluginsConfig{}, fmt.Errorf("unable to use the Helm storage driver of the plugins config: %w", err)
+	}
+	return config, nil
+}
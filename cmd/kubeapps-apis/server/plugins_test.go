@@ -16,12 +16,14 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"io/ioutil"
 	"path/filepath"
 	"testing"
 	"testing/fstest"
 
 	"github.com/google/go-cmp/cmp"
 	plugins "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/plugins/v1alpha1"
+	"github.com/kubeapps/kubeapps/pkg/agent"
 	"github.com/kubeapps/kubeapps/pkg/kube"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -399,3 +401,93 @@ func TestCreateConfigGetterWithParams(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPluginsConfigFromServeOpts(t *testing.T) {
+	testCases := []struct {
+		name           string
+		configContent  string
+		expectedConfig PluginsConfig
+		expectedErr    bool
+	}{
+		{
+			name:           "it returns an empty config without a config path",
+			expectedConfig: PluginsConfig{},
+		},
+		{
+			name:          "it parses the storage driver of the helm plugins from YAML",
+			configContent: "helm:\n  storageDriver: configmaps\n",
+			expectedConfig: PluginsConfig{
+				Helm: HelmPluginsConfig{StorageDriver: "configmaps"},
+			},
+		},
+		{
+			name:          "it parses the storage driver of the helm plugins from JSON",
+			configContent: `{"helm": {"storageDriver": "secrets"}}`,
+			expectedConfig: PluginsConfig{
+				Helm: HelmPluginsConfig{StorageDriver: "secrets"},
+			},
+		},
+		{
+			name:          "it returns an error for an unknown storage driver",
+			configContent: "helm:\n  storageDriver: unknown\n",
+			expectedErr:   true,
+		},
+		{
+			name:          "it returns an error for an unknown field",
+			configContent: "helm:\n  driver: secrets\n",
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serveOpts := ServeOptions{}
+			if tc.configContent != "" {
+				serveOpts.PluginConfigPath = filepath.Join(t.TempDir(), "plugins.conf")
+				if err := ioutil.WriteFile(serveOpts.PluginConfigPath, []byte(tc.configContent), 0600); err != nil {
+					t.Fatalf("%+v", err)
+				}
+			}
+
+			config, err := getPluginsConfigFromServeOpts(serveOpts, kube.ClustersConfig{})
+			if got, want := err != nil, tc.expectedErr; got != want {
+				t.Fatalf("got error: %t, want error: %t, err: %+v", got, want, err)
+			}
+			if got, want := config, tc.expectedConfig; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestHelmStorageForDriver(t *testing.T) {
+	for _, storageDriver := range []string{"", "secrets", "configmaps"} {
+		t.Run(storageDriver, func(t *testing.T) {
+			storageForDriver, err := HelmPluginsConfig{StorageDriver: storageDriver}.StorageForDriver(kube.ClustersConfig{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if storageForDriver == nil {
+				t.Errorf("got: nil, want: a storage for the driver %q", storageDriver)
+			}
+		})
+	}
+}
+
+func TestHelmStorageForDriverMemory(t *testing.T) {
+	if _, err := (HelmPluginsConfig{StorageDriver: "memory"}).StorageForDriver(kube.ClustersConfig{}); err == nil {
+		t.Errorf("got: nil, want: an error for the memory driver")
+	}
+}
+
+func TestHelmStorageForDriverSQLWithSeveralClusters(t *testing.T) {
+	t.Setenv(agent.SQLConnectionStringEnvVar, "postgres://kubeapps@localhost/helm")
+	helmConfig := HelmPluginsConfig{StorageDriver: "sql"}
+
+	if _, err := helmConfig.StorageForDriver(kube.ClustersConfig{Clusters: map[string]kube.ClusterConfig{"default": {}}}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, err := helmConfig.StorageForDriver(kube.ClustersConfig{Clusters: map[string]kube.ClusterConfig{"default": {}, "other": {}}}); err == nil {
+		t.Errorf("got: nil, want: an error for the sql driver with more than one cluster")
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"github.com/pmezard/go-difflib/difflib"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// clusterScopedKinds are the kinds of the common cluster-scoped resources
+// which a package may create. Manifests do not need to specify the namespace
+// of namespaced resources, so without querying the API server these are the
+// only resources which are not given the default namespace.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"PersistentVolume":               true,
+	"PodSecurityPolicy":              true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// ResourceRefsFromManifest returns the references to the resources of a
+// multi-document YAML manifest, such as that of a helm release. Namespaced
+// resources without a namespace are given the default namespace.
+func ResourceRefsFromManifest(manifest, defaultNamespace string) ([]*packages.ResourceRef, error) {
+	objs, err := manifestObjects(manifest, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]*packages.ResourceRef, len(objs))
+	for i, obj := range objs {
+		refs[i] = obj.ref
+	}
+	return refs, nil
+}
+
+// DiffManifests returns the resources which are added, removed or changed
+// between two multi-document YAML manifests, such as the deployed manifest of
+// a helm release and the manifest of its upgrade. Resources are matched by
+// their kind, namespace and name, so that a change of api version, such as
+// from extensions/v1beta1 to apps/v1, is reported as a change rather than a
+// removal and an addition.
+func DiffManifests(current, target, defaultNamespace string) ([]*packages.ResourceDiff, error) {
+	currentObjs, err := manifestObjects(current, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+	targetObjs, err := manifestObjects(target, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByKey := map[string]manifestObject{}
+	for _, obj := range currentObjs {
+		currentByKey[obj.key()] = obj
+	}
+	targetKeys := map[string]bool{}
+
+	diffs := []*packages.ResourceDiff{}
+	for _, targetObj := range targetObjs {
+		key := targetObj.key()
+		targetKeys[key] = true
+		currentObj, ok := currentByKey[key]
+		if !ok {
+			diff, err := unifiedDiff("", targetObj.yaml)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, &packages.ResourceDiff{
+				ResourceRef: targetObj.ref,
+				DiffType:    packages.ResourceDiff_DIFF_TYPE_ADDED,
+				Diff:        diff,
+			})
+			continue
+		}
+		if currentObj.yaml == targetObj.yaml {
+			continue
+		}
+		diff, err := unifiedDiff(currentObj.yaml, targetObj.yaml)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, &packages.ResourceDiff{
+			ResourceRef: targetObj.ref,
+			DiffType:    packages.ResourceDiff_DIFF_TYPE_CHANGED,
+			Diff:        diff,
+		})
+	}
+	for _, currentObj := range currentObjs {
+		if targetKeys[currentObj.key()] {
+			continue
+		}
+		diff, err := unifiedDiff(currentObj.yaml, "")
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, &packages.ResourceDiff{
+			ResourceRef: currentObj.ref,
+			DiffType:    packages.ResourceDiff_DIFF_TYPE_REMOVED,
+			Diff:        diff,
+		})
+	}
+	return diffs, nil
+}
+
+// manifestObject is a resource of a manifest with its reference and its
+// YAML, normalized so that resources can be compared.
+type manifestObject struct {
+	ref  *packages.ResourceRef
+	yaml string
+}
+
+func (o manifestObject) key() string {
+	return strings.Join([]string{o.ref.Kind, o.ref.Namespace, o.ref.Name}, "/")
+}
+
+// manifestObjects decodes the resources of a multi-document YAML manifest.
+func manifestObjects(manifest, defaultNamespace string) ([]manifestObject, error) {
+	objs := []manifestObject{}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("unable to decode the manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			// An empty document, eg. a template rendered empty.
+			continue
+		}
+		namespace := obj.GetNamespace()
+		if clusterScopedKinds[obj.GetKind()] {
+			namespace = ""
+		} else if namespace == "" {
+			namespace = defaultNamespace
+		}
+		// Marshalling sorts the keys, so that only changes of the values
+		// are reported.
+		normalized, err := sigsyaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode the resource %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		objs = append(objs, manifestObject{
+			ref: &packages.ResourceRef{
+				ApiVersion: obj.GetAPIVersion(),
+				Kind:       obj.GetKind(),
+				Name:       obj.GetName(),
+				Namespace:  namespace,
+			},
+			yaml: string(normalized),
+		})
+	}
+	return objs, nil
+}
+
+// unifiedDiff returns the unified diff between the current and the target
+// YAML of a resource, either of which is empty when the resource is added or
+// removed.
+func unifiedDiff(current, target string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(target),
+		FromFile: "current",
+		ToFile:   "target",
+		Context:  3,
+	})
+}
+
+// splitLines splits a YAML document into lines, without returning a single
+// empty line for an empty document as difflib.SplitLines does.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(s, "\n"))
+}
+
+// NewResourceMapper returns a mapper from the kinds of resources to the
+// resources served by the API server, including those of custom resource
+// definitions, which are discovered when first needed.
+func NewResourceMapper(discoveryClient discovery.DiscoveryInterface) meta.RESTMapper {
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+}
+
+// GetResourceStatuses returns the readiness of each of the referenced
+// resources, fetched with the given client so that only the resources which
+// the user can read are checked. The kinds of the references are mapped to
+// resources with the given mapper, see NewResourceMapper.
+func GetResourceStatuses(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, refs []*packages.ResourceRef) []*packages.ResourceStatus {
+	statuses := make([]*packages.ResourceStatus, len(refs))
+	for i, ref := range refs {
+		statuses[i] = &packages.ResourceStatus{ResourceRef: ref}
+		if ref.ApiVersion == "" {
+			statuses[i].Reason = "Unknown api version"
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.ApiVersion)
+		if err != nil {
+			statuses[i].Reason = fmt.Sprintf("Invalid api version: %v", err)
+			continue
+		}
+		// Plugins only know the kind of the resources, so the resource is
+		// looked up as served by the API server.
+		mapping, err := mapper.RESTMapping(gv.WithKind(ref.Kind).GroupKind(), gv.Version)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				statuses[i].Reason = "Unknown kind"
+			} else {
+				statuses[i].Reason = err.Error()
+			}
+			continue
+		}
+
+		var resourceIfc dynamic.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			resourceIfc = client.Resource(mapping.Resource).Namespace(ref.Namespace)
+		}
+		obj, err := resourceIfc.Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			switch {
+			case k8serrors.IsNotFound(err):
+				statuses[i].Reason = "Not found"
+			case k8serrors.IsForbidden(err):
+				statuses[i].Reason = "Forbidden"
+			default:
+				statuses[i].Reason = err.Error()
+			}
+			continue
+		}
+		statuses[i].Ready, statuses[i].Reason = resourceReadiness(obj)
+	}
+	return statuses
+}
+
+// resourceReadiness returns whether a resource is ready, with the reason when
+// it is not, for the kinds whose readiness is known, falling back to the
+// Ready condition, if any, for other kinds.
+func resourceReadiness(obj *unstructured.Unstructured) (bool, string) {
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		// The status is only meaningful once the controller has observed the
+		// latest spec.
+		observedGeneration, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+		if observedGeneration < obj.GetGeneration() {
+			return false, "Waiting for the latest spec to be observed"
+		}
+		replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+		if obj.GetKind() == "Deployment" {
+			// Available replicas may still run the previous template while
+			// a rollout is in progress or stalled.
+			updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+			if updated < replicas {
+				return false, fmt.Sprintf("%d/%d replicas updated", updated, replicas)
+			}
+		}
+		field := "availableReplicas"
+		if obj.GetKind() != "Deployment" {
+			field = "readyReplicas"
+		}
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+		if ready < replicas {
+			return false, fmt.Sprintf("%d/%d replicas ready", ready, replicas)
+		}
+		return true, ""
+	case "DaemonSet":
+		desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		if ready < desired {
+			return false, fmt.Sprintf("%d/%d pods ready", ready, desired)
+		}
+		return true, ""
+	case "Job":
+		completions, found, _ := unstructured.NestedInt64(obj.Object, "spec", "completions")
+		if !found {
+			completions = 1
+		}
+		succeeded, _, _ := unstructured.NestedInt64(obj.Object, "status", "succeeded")
+		if succeeded < completions {
+			return false, fmt.Sprintf("%d/%d completions", succeeded, completions)
+		}
+		return true, ""
+	case "PersistentVolumeClaim":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase != "Bound" {
+			return false, fmt.Sprintf("Phase %s", phase)
+		}
+		return true, ""
+	case "Service":
+		serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+		if serviceType == "LoadBalancer" {
+			ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+			if len(ingress) == 0 {
+				return false, "Waiting for the load balancer"
+			}
+		}
+		return true, ""
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if condition["status"] == "True" {
+			return true, ""
+		}
+		reason, _ := condition["reason"].(string)
+		return false, reason
+	}
+	// Resources without a known readiness are ready once they exist.
+	return true, ""
+}
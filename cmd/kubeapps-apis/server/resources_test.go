@@ -0,0 +1,354 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestResourceRefsFromManifest(t *testing.T) {
+	manifest := `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-apache
+---
+# An empty template
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-apache-config
+  namespace: other
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: my-apache
+  namespace: ignored
+`
+	expected := []*packages.ResourceRef{
+		{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-apache", Namespace: "default"},
+		{ApiVersion: "v1", Kind: "ConfigMap", Name: "my-apache-config", Namespace: "other"},
+		{ApiVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "my-apache"},
+	}
+
+	refs, err := ResourceRefsFromManifest(manifest, "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	opts := cmpopts.IgnoreUnexported(packages.ResourceRef{})
+	if got, want := refs, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
+
+func TestDiffManifests(t *testing.T) {
+	current := `
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: my-apache
+spec:
+  replicas: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-apache-config
+data:
+  foo: bar
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-apache-removed
+`
+	target := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-apache
+spec:
+  replicas: 2
+---
+# The keys are in a different order but the config map is unchanged.
+apiVersion: v1
+data:
+  foo: bar
+kind: ConfigMap
+metadata:
+  name: my-apache-config
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: my-apache-added
+`
+	expected := []*packages.ResourceDiff{
+		{
+			ResourceRef: &packages.ResourceRef{ApiVersion: "apps/v1", Kind: "Deployment", Name: "my-apache", Namespace: "default"},
+			DiffType:    packages.ResourceDiff_DIFF_TYPE_CHANGED,
+			Diff: `--- current
++++ target
+@@ -1,6 +1,6 @@
+-apiVersion: extensions/v1beta1
++apiVersion: apps/v1
+ kind: Deployment
+ metadata:
+   name: my-apache
+ spec:
+-  replicas: 1
++  replicas: 2
+`,
+		},
+		{
+			ResourceRef: &packages.ResourceRef{ApiVersion: "v1", Kind: "Service", Name: "my-apache-added", Namespace: "default"},
+			DiffType:    packages.ResourceDiff_DIFF_TYPE_ADDED,
+			Diff: `--- current
++++ target
+@@ -0,0 +1,4 @@
++apiVersion: v1
++kind: Service
++metadata:
++  name: my-apache-added
+`,
+		},
+		{
+			ResourceRef: &packages.ResourceRef{ApiVersion: "v1", Kind: "Secret", Name: "my-apache-removed", Namespace: "default"},
+			DiffType:    packages.ResourceDiff_DIFF_TYPE_REMOVED,
+			Diff: `--- current
++++ target
+@@ -1,4 +0,0 @@
+-apiVersion: v1
+-kind: Secret
+-metadata:
+-  name: my-apache-removed
+`,
+		},
+	}
+
+	diffs, err := DiffManifests(current, target, "default")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	opts := cmpopts.IgnoreUnexported(packages.ResourceDiff{}, packages.ResourceRef{})
+	if got, want := diffs, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
+
+func TestResourceReadiness(t *testing.T) {
+	testCases := []struct {
+		name           string
+		object         map[string]interface{}
+		expectedReady  bool
+		expectedReason string
+	}{
+		{
+			name: "a deployment with all replicas updated and available is ready",
+			object: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(2)},
+				"spec":     map[string]interface{}{"replicas": int64(2)},
+				"status":   map[string]interface{}{"observedGeneration": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			},
+			expectedReady: true,
+		},
+		{
+			name: "a deployment whose latest spec is not observed yet is not ready",
+			object: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"spec":     map[string]interface{}{"replicas": int64(2)},
+				"status":   map[string]interface{}{"observedGeneration": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			},
+			expectedReason: "Waiting for the latest spec to be observed",
+		},
+		{
+			name: "a deployment with a stalled rollout is not ready",
+			object: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(2)},
+				"spec":     map[string]interface{}{"replicas": int64(2)},
+				"status":   map[string]interface{}{"observedGeneration": int64(2), "updatedReplicas": int64(1), "availableReplicas": int64(2)},
+			},
+			expectedReason: "1/2 replicas updated",
+		},
+		{
+			name: "a statefulset without all replicas ready is not ready",
+			object: map[string]interface{}{
+				"kind":   "StatefulSet",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(1)},
+			},
+			expectedReason: "1/3 replicas ready",
+		},
+		{
+			name: "a daemonset without all pods ready is not ready",
+			object: map[string]interface{}{
+				"kind":   "DaemonSet",
+				"status": map[string]interface{}{"desiredNumberScheduled": int64(2), "numberReady": int64(0)},
+			},
+			expectedReason: "0/2 pods ready",
+		},
+		{
+			name: "an unbound pvc is not ready",
+			object: map[string]interface{}{
+				"kind":   "PersistentVolumeClaim",
+				"status": map[string]interface{}{"phase": "Pending"},
+			},
+			expectedReason: "Phase Pending",
+		},
+		{
+			name: "a load balancer service without ingress is not ready",
+			object: map[string]interface{}{
+				"kind": "Service",
+				"spec": map[string]interface{}{"type": "LoadBalancer"},
+			},
+			expectedReason: "Waiting for the load balancer",
+		},
+		{
+			name: "a resource with a false ready condition is not ready",
+			object: map[string]interface{}{
+				"kind": "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "reason": "Issuing"},
+					},
+				},
+			},
+			expectedReason: "Issuing",
+		},
+		{
+			name: "a resource without known readiness is ready",
+			object: map[string]interface{}{
+				"kind": "ConfigMap",
+			},
+			expectedReady: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, reason := resourceReadiness(&unstructured.Unstructured{Object: tc.object})
+
+			if got, want := ready, tc.expectedReady; got != want {
+				t.Errorf("got: %t, want: %t", got, want)
+			}
+			if got, want := reason, tc.expectedReason; got != want {
+				t.Errorf("got: %q, want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGetResourceStatuses(t *testing.T) {
+	// The plural of the custom resource cannot be guessed from its kind.
+	fooGVR := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "foozles"}
+	discoveryClient := &fakediscovery.FakeDiscovery{
+		Fake: &k8stesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "services", Kind: "Service", Namespaced: true},
+					},
+				},
+				{
+					GroupVersion: "rbac.authorization.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "clusterroles", Kind: "ClusterRole"},
+					},
+				},
+				{
+					GroupVersion: "example.com/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "foozles", Kind: "Foo", Namespaced: true},
+					},
+				},
+			},
+		},
+	}
+	foo := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata": map[string]interface{}{
+				"name":      "my-foo",
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			},
+		},
+	}
+	clusterRole := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"name": "my-role",
+			},
+		},
+	}
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			fooGVR: "FooList",
+		},
+		clusterRole,
+	)
+	// Added with its resource, which the fake client would otherwise guess.
+	if _, err := dynamicClient.Resource(fooGVR).Namespace("default").Create(context.Background(), foo, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	refs := []*packages.ResourceRef{
+		{ApiVersion: "example.com/v1", Kind: "Foo", Name: "my-foo", Namespace: "default"},
+		// A namespace given to a cluster-scoped resource is ignored.
+		{ApiVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "my-role", Namespace: "default"},
+		{ApiVersion: "v1", Kind: "Service", Name: "my-service", Namespace: "default"},
+		{ApiVersion: "example.com/v1", Kind: "Bar", Name: "my-bar", Namespace: "default"},
+	}
+	expected := []*packages.ResourceStatus{
+		{ResourceRef: refs[0], Ready: true},
+		{ResourceRef: refs[1], Ready: true},
+		{ResourceRef: refs[2], Reason: "Not found"},
+		{ResourceRef: refs[3], Reason: "Unknown kind"},
+	}
+
+	statuses := GetResourceStatuses(context.Background(), dynamicClient, NewResourceMapper(discoveryClient), refs)
+
+	opts := cmpopts.IgnoreUnexported(packages.ResourceRef{}, packages.ResourceStatus{})
+	if got, want := statuses, expected; !cmp.Equal(want, got, opts) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got, opts))
+	}
+}
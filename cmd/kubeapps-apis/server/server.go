@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/soheilhy/cmux"
@@ -38,7 +39,9 @@ type ServeOptions struct {
 	Port               int
 	PluginDirs         []string
 	ClustersConfigPath string
+	PluginConfigPath   string
 	PinnipedProxyURL   string
+	PluginTimeout      time.Duration
 	//temporary flags while this component in under heavy development
 	UnsafeUseDemoSA          bool
 	UnsafeLocalDevKubeconfig bool
@@ -76,7 +79,7 @@ func Serve(serveOpts ServeOptions) {
 	}
 
 	// Create the core.packages server and register it for both grpc and http.
-	packages.RegisterPackagesServiceServer(grpcSrv, NewPackagesServer(pluginsServer.packagesPlugins))
+	packages.RegisterPackagesServiceServer(grpcSrv, NewPackagesServer(pluginsServer.packagesPlugins, serveOpts.PluginTimeout))
 	err = packages.RegisterPackagesServiceHandlerFromEndpoint(gwArgs.ctx, gwArgs.mux, gwArgs.addr, gwArgs.dialOptions)
 	if err != nil {
 		log.Fatalf("failed to register core.packages handler for gateway: %v", err)
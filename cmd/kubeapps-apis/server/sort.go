@@ -0,0 +1,181 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+)
+
+// SortAvailablePackageSummaries sorts the given summaries in the order
+// specified by the sort options. It is used by the core API when merging the
+// results of several plugins, and can be used by plugins which sort their
+// results in memory so that both agree on the order.
+func SortAvailablePackageSummaries(pkgs []*packages.AvailablePackageSummary, sortOptions *packages.SortOptions) {
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		return CompareAvailablePackageSummaries(pkgs[i], pkgs[j], sortOptions) < 0
+	})
+}
+
+// CompareAvailablePackageSummaries returns a negative number when a sorts
+// before b, a positive number when a sorts after b and zero otherwise. Ties on
+// the sort field are broken by the name, namespace and then the identifier
+// (and plugin) of the package, always in ascending order, so that the order is
+// total.
+//
+// Plugins paginating their results elsewhere must return them in this same
+// order for the merged pages to be consistent, so all strings are compared
+// bytewise and versions as described in compareSortableVersions. The ORDER BY
+// clause of the assets database (see GenerateOrderByClause in
+// cmd/assetsvc/pkg/utils) implements the same ordering and the two must be
+// kept in sync.
+func CompareAvailablePackageSummaries(a, b *packages.AvailablePackageSummary, sortOptions *packages.SortOptions) int {
+	c := 0
+	switch sortOptions.GetField() {
+	case packages.SortOptions_SORT_FIELD_LATEST_VERSION:
+		versionA, okA := parseSortableVersion(a.GetLatestVersion().GetPkgVersion())
+		versionB, okB := parseSortableVersion(b.GetLatestVersion().GetPkgVersion())
+		switch {
+		case !okA && !okB:
+			c = 0
+		case !okA:
+			// Packages without a valid version are always sorted last.
+			return 1
+		case !okB:
+			return -1
+		default:
+			c = compareSortableVersions(versionA, versionB)
+		}
+	case packages.SortOptions_SORT_FIELD_REPOSITORY:
+		namespaceA, repoA := packageRepository(a)
+		namespaceB, repoB := packageRepository(b)
+		if c = strings.Compare(namespaceA, namespaceB); c == 0 {
+			c = strings.Compare(repoA, repoB)
+		}
+	default:
+		c = strings.Compare(a.GetName(), b.GetName())
+	}
+	if sortOptions.GetDescending() {
+		c = -c
+	}
+	if c != 0 {
+		return c
+	}
+
+	if c = strings.Compare(a.GetName(), b.GetName()); c != 0 {
+		return c
+	}
+	if c = strings.Compare(a.GetAvailablePackageRef().GetContext().GetNamespace(), b.GetAvailablePackageRef().GetContext().GetNamespace()); c != 0 {
+		return c
+	}
+	if c = strings.Compare(a.GetAvailablePackageRef().GetIdentifier(), b.GetAvailablePackageRef().GetIdentifier()); c != 0 {
+		return c
+	}
+	return strings.Compare(a.GetAvailablePackageRef().GetPlugin().GetName(), b.GetAvailablePackageRef().GetPlugin().GetName())
+}
+
+// sortableVersionRegex matches the versions which can be sorted: an optional
+// "v" prefix, dot separated numeric release components, an optional
+// prerelease and optional build metadata.
+var sortableVersionRegex = regexp.MustCompile(`^v?([0-9]+(?:\.[0-9]+)*)(?:-([0-9A-Za-z.-]+))?(?:\+.*)?$`)
+
+type sortableVersion struct {
+	release    []string
+	prerelease string
+}
+
+func parseSortableVersion(version string) (sortableVersion, bool) {
+	match := sortableVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		return sortableVersion{}, false
+	}
+	return sortableVersion{release: strings.Split(match[1], "."), prerelease: match[2]}, true
+}
+
+// compareSortableVersions compares the release components numerically, a
+// release with fewer components sorting first when the others are equal (so
+// 1.2 < 1.2.0). For the same release, prereleases sort before the release
+// itself and are compared bytewise with each other. Build metadata is ignored.
+func compareSortableVersions(a, b sortableVersion) int {
+	for i := 0; i < len(a.release) && i < len(b.release); i++ {
+		if c := compareNumericStrings(a.release[i], b.release[i]); c != 0 {
+			return c
+		}
+	}
+	if len(a.release) != len(b.release) {
+		if len(a.release) < len(b.release) {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case a.prerelease == b.prerelease:
+		return 0
+	case a.prerelease == "":
+		return 1
+	case b.prerelease == "":
+		return -1
+	}
+	return strings.Compare(a.prerelease, b.prerelease)
+}
+
+// compareNumericStrings compares two strings of digits of any length by their
+// numeric value.
+func compareNumericStrings(a, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// packageRepository returns the repository of a package as the namespace of
+// its reference and the repository part of its identifier, when the plugin
+// identifies packages as "repository/name".
+func packageRepository(pkg *packages.AvailablePackageSummary) (namespace, repo string) {
+	if parts := strings.SplitN(pkg.GetAvailablePackageRef().GetIdentifier(), "/", 2); len(parts) == 2 {
+		repo = parts[0]
+	}
+	return pkg.GetAvailablePackageRef().GetContext().GetNamespace(), repo
+}
+
+// SortInstalledPackageSummaries sorts the given summaries by name, breaking
+// ties by the namespace, identifier and plugin of the package. Plugins which
+// paginate their installed packages must return them in this same order.
+func SortInstalledPackageSummaries(pkgs []*packages.InstalledPackageSummary) {
+	sort.SliceStable(pkgs, func(i, j int) bool {
+		return CompareInstalledPackageSummaries(pkgs[i], pkgs[j]) < 0
+	})
+}
+
+// CompareInstalledPackageSummaries returns a negative number when a sorts
+// before b, a positive number when a sorts after b and zero otherwise.
+func CompareInstalledPackageSummaries(a, b *packages.InstalledPackageSummary) int {
+	refA, refB := a.GetInstalledPackageRef(), b.GetInstalledPackageRef()
+	if c := strings.Compare(a.GetName(), b.GetName()); c != 0 {
+		return c
+	}
+	if c := strings.Compare(refA.GetContext().GetNamespace(), refB.GetContext().GetNamespace()); c != 0 {
+		return c
+	}
+	if c := strings.Compare(refA.GetIdentifier(), refB.GetIdentifier()); c != 0 {
+		return c
+	}
+	return strings.Compare(refA.GetPlugin().GetName(), refB.GetPlugin().GetName())
+}
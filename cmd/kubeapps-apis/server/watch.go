@@ -0,0 +1,70 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"fmt"
+
+	packages "github.com/kubeapps/kubeapps/cmd/kubeapps-apis/gen/core/packages/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// InstalledPackageStatusSender is used by the plugins to send the status of
+// their installed packages on a WatchInstalledPackages stream. As the
+// underlying watches report every change of the watched resources, it only
+// sends a status when it differs from the last one sent for the package.
+type InstalledPackageStatusSender struct {
+	stream     packages.PackagesService_WatchInstalledPackagesServer
+	lastStatus map[string]*packages.InstalledPackageStatus
+}
+
+// NewInstalledPackageStatusSender returns a sender for the given stream.
+func NewInstalledPackageStatusSender(stream packages.PackagesService_WatchInstalledPackagesServer) *InstalledPackageStatusSender {
+	return &InstalledPackageStatusSender{
+		stream:     stream,
+		lastStatus: map[string]*packages.InstalledPackageStatus{},
+	}
+}
+
+// Send sends the status of the installed package if it has changed.
+func (s *InstalledPackageStatusSender) Send(ref *packages.InstalledPackageReference, status *packages.InstalledPackageStatus) error {
+	key := installedPackageKey(ref)
+	if last, ok := s.lastStatus[key]; ok && proto.Equal(last, status) {
+		return nil
+	}
+	s.lastStatus[key] = status
+	return s.stream.Send(&packages.WatchInstalledPackagesResponse{
+		InstalledPackageRef: ref,
+		Status:              status,
+	})
+}
+
+// SendDeleted sends the deletion of the installed package, together with its
+// last status, if its status was sent before.
+func (s *InstalledPackageStatusSender) SendDeleted(ref *packages.InstalledPackageReference) error {
+	key := installedPackageKey(ref)
+	last, ok := s.lastStatus[key]
+	if !ok {
+		return nil
+	}
+	delete(s.lastStatus, key)
+	return s.stream.Send(&packages.WatchInstalledPackagesResponse{
+		InstalledPackageRef: ref,
+		Status:              last,
+		Deleted:             true,
+	})
+}
+
+func installedPackageKey(ref *packages.InstalledPackageReference) string {
+	return fmt.Sprintf("%s/%s/%s", ref.GetContext().GetCluster(), ref.GetContext().GetNamespace(), ref.GetIdentifier())
+}
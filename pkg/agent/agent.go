@@ -3,7 +3,9 @@ package agent
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/kubeapps/kubeapps/pkg/chart/helm3to2"
 	"github.com/kubeapps/kubeapps/pkg/proxy"
@@ -21,27 +23,58 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// SQLConnectionStringEnvVar is the environment variable with the connection
+// string of the SQL driver, as for the helm CLI.
+const SQLConnectionStringEnvVar = "HELM_DRIVER_SQL_CONNECTION_STRING"
+
 // StorageForDriver is a function type which returns a specific storage.
-type StorageForDriver func(namespace string, clientset *kubernetes.Clientset) *storage.Storage
+type StorageForDriver func(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error)
 
 // StorageForSecrets returns a storage using the Secret driver.
-func StorageForSecrets(namespace string, clientset *kubernetes.Clientset) *storage.Storage {
+func StorageForSecrets(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error) {
 	d := driver.NewSecrets(clientset.CoreV1().Secrets(namespace))
 	d.Log = log.Infof
-	return storage.Init(d)
+	return storage.Init(d), nil
 }
 
 // StorageForConfigMaps returns a storage using the ConfigMap driver.
-func StorageForConfigMaps(namespace string, clientset *kubernetes.Clientset) *storage.Storage {
+func StorageForConfigMaps(namespace string, clientset *kubernetes.Clientset) (*storage.Storage, error) {
 	d := driver.NewConfigMaps(clientset.CoreV1().ConfigMaps(namespace))
 	d.Log = log.Infof
-	return storage.Init(d)
+	return storage.Init(d), nil
 }
 
 // StorageForMemory returns a storage using the Memory driver.
-func StorageForMemory(_ string, _ *kubernetes.Clientset) *storage.Storage {
+func StorageForMemory(_ string, _ *kubernetes.Clientset) (*storage.Storage, error) {
 	d := driver.NewMemory()
-	return storage.Init(d)
+	return storage.Init(d), nil
+}
+
+// StorageForSQL returns a function returning storages using the SQL driver
+// with the given connection string.
+//
+// Each SQL driver holds a pool of connections to the database, so a single
+// driver is created per namespace and reused. The database only records the
+// namespace of the releases, so the returned function must only be used for
+// the releases of a single cluster. Note that the releases stored in the
+// database are not protected by the RBAC of the cluster.
+func StorageForSQL(connectionString string) StorageForDriver {
+	var mutex sync.Mutex
+	drivers := map[string]*driver.SQL{}
+	return func(namespace string, _ *kubernetes.Clientset) (*storage.Storage, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		d, ok := drivers[namespace]
+		if !ok {
+			var err error
+			d, err = driver.NewSQL(connectionString, log.Infof, namespace)
+			if err != nil {
+				return nil, fmt.Errorf("unable to connect to the SQL storage of the Helm releases: %w", err)
+			}
+			drivers[namespace] = d
+		}
+		return storage.Init(d), nil
+	}
 }
 
 // ListReleases lists releases in the specified namespace, or all namespaces if the empty string is given.
@@ -98,6 +131,64 @@ func CreateRelease(actionConfig *action.Configuration, name, namespace, valueStr
 	return release, nil
 }
 
+// RenderRelease renders the manifest of a release, including its hooks, as it
+// would be installed, without accessing the cluster nor persisting the release.
+func RenderRelease(name, namespace, valueString string, ch *chart.Chart, registrySecrets map[string]string) (string, error) {
+	// A client-only install replaces the kube client and storage of the
+	// configuration with fakes, so it has its own configuration.
+	cmd := action.NewInstall(&action.Configuration{Log: log.Infof})
+	cmd.DryRun = true
+	cmd.ClientOnly = true
+	cmd.ReleaseName = name
+	cmd.Namespace = namespace
+	var err error
+	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return "", err
+	}
+	values, err := getValues([]byte(valueString))
+	if err != nil {
+		return "", err
+	}
+	release, err := cmd.Run(ch, values)
+	if err != nil {
+		return "", fmt.Errorf("Unable to render the release %q: %v", name, err)
+	}
+	return ReleaseManifest(release), nil
+}
+
+// RenderUpgradeRelease renders the manifest of a release, including its hooks,
+// as it would be upgraded, without persisting the upgrade.
+func RenderUpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string, ch *chart.Chart, registrySecrets map[string]string) (string, error) {
+	cmd := action.NewUpgrade(actionConfig)
+	cmd.DryRun = true
+	var err error
+	cmd.PostRenderer, err = NewDockerSecretsPostRenderer(registrySecrets)
+	if err != nil {
+		return "", err
+	}
+	values, err := chartutil.ReadValues([]byte(valuesYaml))
+	if err != nil {
+		return "", fmt.Errorf("Unable to render the upgrade because values could not be parsed: %v", err)
+	}
+	release, err := cmd.Run(name, ch, values)
+	if err != nil {
+		return "", fmt.Errorf("Unable to render the upgrade of the release %q: %v", name, err)
+	}
+	return ReleaseManifest(release), nil
+}
+
+// ReleaseManifest returns the manifest of a release followed by the manifests
+// of its hooks, as `helm template` outputs them.
+func ReleaseManifest(r *release.Release) string {
+	var manifest strings.Builder
+	fmt.Fprintln(&manifest, strings.TrimSpace(r.Manifest))
+	for _, hook := range r.Hooks {
+		fmt.Fprintf(&manifest, "---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+	return manifest.String()
+}
+
 // UpgradeRelease upgrades a release.
 func UpgradeRelease(actionConfig *action.Configuration, name, valuesYaml string, ch *chart.Chart, registrySecrets map[string]string) (*release.Release, error) {
 	// Check if the release already exists:
@@ -162,7 +253,10 @@ func DeleteRelease(actionConfig *action.Configuration, name string, keepHistory
 // Among other things, the action.Configuration controls which namespace the command is run against.
 func NewActionConfig(storageForDriver StorageForDriver, config *rest.Config, clientset *kubernetes.Clientset, namespace string) (*action.Configuration, error) {
 	actionConfig := new(action.Configuration)
-	store := storageForDriver(namespace, clientset)
+	store, err := storageForDriver(namespace, clientset)
+	if err != nil {
+		return nil, err
+	}
 	restClientGetter := NewConfigFlagsFromCluster(namespace, config)
 	actionConfig.RESTClientGetter = restClientGetter
 	actionConfig.KubeClient = kube.New(restClientGetter)
@@ -209,6 +303,8 @@ func stringptr(val string) *string {
 }
 
 // ParseDriverType maps strings to well-typed driver representations.
+// The connection string of the SQL driver is read from the
+// HELM_DRIVER_SQL_CONNECTION_STRING environment variable.
 func ParseDriverType(raw string) (StorageForDriver, error) {
 	switch raw {
 	case "secret", "secrets":
@@ -217,6 +313,12 @@ func ParseDriverType(raw string) (StorageForDriver, error) {
 		return StorageForConfigMaps, nil
 	case "memory":
 		return StorageForMemory, nil
+	case "sql":
+		connectionString := os.Getenv(SQLConnectionStringEnvVar)
+		if connectionString == "" {
+			return nil, errors.New("The SQL Helm driver requires a connection string in " + SQLConnectionStringEnvVar)
+		}
+		return StorageForSQL(connectionString), nil
 	default:
 		return nil, errors.New("Invalid Helm driver type: " + raw)
 	}
@@ -0,0 +1,34 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+// MergeValues deep-merges the src values into a copy of the dest values, as
+// helm does for multiple values files and the flux helm-controller does for
+// the values of a HelmRelease: nested maps are merged while any other value
+// of src replaces that of dest.
+func MergeValues(dest, src map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(dest))
+	for k, v := range dest {
+		out[k] = v
+	}
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]interface{}); ok {
+			if destMap, ok := out[k].(map[string]interface{}); ok {
+				out[k] = MergeValues(destMap, srcMap)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2021 VMware
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeValues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dest     map[string]interface{}
+		src      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "merges nested maps",
+			dest:     map[string]interface{}{"image": map[string]interface{}{"repository": "nginx", "tag": "1.0"}},
+			src:      map[string]interface{}{"image": map[string]interface{}{"tag": "2.0"}},
+			expected: map[string]interface{}{"image": map[string]interface{}{"repository": "nginx", "tag": "2.0"}},
+		},
+		{
+			name:     "replaces lists and scalars",
+			dest:     map[string]interface{}{"args": []interface{}{"a", "b"}, "replicas": 1},
+			src:      map[string]interface{}{"args": []interface{}{"c"}, "replicas": 2},
+			expected: map[string]interface{}{"args": []interface{}{"c"}, "replicas": 2},
+		},
+		{
+			name:     "replaces a map with a scalar and a scalar with a map",
+			dest:     map[string]interface{}{"a": map[string]interface{}{"b": 1}, "c": "d"},
+			src:      map[string]interface{}{"a": "b", "c": map[string]interface{}{"d": 1}},
+			expected: map[string]interface{}{"a": "b", "c": map[string]interface{}{"d": 1}},
+		},
+		{
+			name:     "keeps the values of dest without src",
+			dest:     map[string]interface{}{"a": 1},
+			expected: map[string]interface{}{"a": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dest := map[string]interface{}{}
+			for k, v := range tc.dest {
+				dest[k] = v
+			}
+
+			if got, want := MergeValues(tc.dest, tc.src), tc.expected; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+			if !cmp.Equal(dest, tc.dest) {
+				t.Errorf("dest was modified (-want +got):\n%s", cmp.Diff(dest, tc.dest))
+			}
+		})
+	}
+}
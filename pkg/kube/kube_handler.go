@@ -65,9 +65,9 @@ type ClusterConfig struct {
 	// include a CAFile field in the config.
 	CAFile string
 	// ServiceToken can be configured so that the Kubeapps application itself
-	// has access to get all namespaces on additional clusters, for example. It
-	// should *not* be for reading secrets or similar, but limited to the
-	// required functionality.
+	// has access to get all namespaces on additional clusters, or to watch the
+	// flux sources cached by the fluxv2 plugin, for example. It should *not* be
+	// for reading secrets or similar, but limited to the required functionality.
 	ServiceToken string
 
 	// Insecure should only be used in test or development environments and enables
@@ -166,6 +166,30 @@ func NewClusterConfig(inClusterConfig *rest.Config, userToken string, cluster st
 	return config, nil
 }
 
+// NewClusterServiceConfig returns the config for the Kubeapps services to
+// access an additional cluster with its configured service token, rather than
+// with the token of a user.
+func NewClusterServiceConfig(cluster string, clustersConfig ClustersConfig) (*rest.Config, error) {
+	clusterConfig, ok := clustersConfig.Clusters[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q has no configuration", cluster)
+	}
+	if clusterConfig.ServiceToken == "" {
+		return nil, fmt.Errorf("cluster %q has no service token configured", cluster)
+	}
+
+	config := &rest.Config{
+		Host:        clusterConfig.APIServiceURL,
+		BearerToken: clusterConfig.ServiceToken,
+	}
+	config.TLSClientConfig.Insecure = clusterConfig.Insecure
+	if clusterConfig.CertificateAuthorityDataDecoded != "" {
+		config.TLSClientConfig.CAData = []byte(clusterConfig.CertificateAuthorityDataDecoded)
+		config.CAFile = clusterConfig.CAFile
+	}
+	return config, nil
+}
+
 func ParseClusterConfig(configPath, caFilesPrefix string, pinnipedProxyURL string) (ClustersConfig, func(), error) {
 	caFilesDir, err := ioutil.TempDir(caFilesPrefix, "")
 	if err != nil {
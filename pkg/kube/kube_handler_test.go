@@ -1422,6 +1422,66 @@ func TestNewClusterConfig(t *testing.T) {
 	}
 }
 
+func TestNewClusterServiceConfig(t *testing.T) {
+	clustersConfig := ClustersConfig{
+		KubeappsClusterName: "default",
+		Clusters: map[string]ClusterConfig{
+			"default": {},
+			"other": {
+				APIServiceURL:                   "https://other.example.com",
+				CertificateAuthorityDataDecoded: "ca-file-data",
+				CAFile:                          "/tmp/other-ca",
+				ServiceToken:                    "service-token",
+			},
+			"no-token": {
+				APIServiceURL: "https://no-token.example.com",
+			},
+		},
+	}
+	testCases := []struct {
+		name           string
+		cluster        string
+		expectedConfig *rest.Config
+		errorExpected  bool
+	}{
+		{
+			name:    "returns a config with the service token of the cluster",
+			cluster: "other",
+			expectedConfig: &rest.Config{
+				Host:        "https://other.example.com",
+				BearerToken: "service-token",
+				TLSClientConfig: rest.TLSClientConfig{
+					CAData: []byte("ca-file-data"),
+					CAFile: "/tmp/other-ca",
+				},
+			},
+		},
+		{
+			name:          "returns an error if the cluster has no service token",
+			cluster:       "no-token",
+			errorExpected: true,
+		},
+		{
+			name:          "returns an error if the cluster is not configured",
+			cluster:       "unknown",
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := NewClusterServiceConfig(tc.cluster, clustersConfig)
+			if got, want := err != nil, tc.errorExpected; got != want {
+				t.Fatalf("got: %t, want: %t. err: %+v", got, want, err)
+			}
+
+			if got, want := config, tc.expectedConfig; !cmp.Equal(want, got) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
 func TestParseSelfSubjectAccessRequest(t *testing.T) {
 	testCases := []struct {
 		name          string